@@ -12,11 +12,6 @@ func Build() error {
 	return sh.RunV("go", "build", "./...")
 }
 
-// DataGenerate runs go generate (code generation) on the data package.
-func DataGenerate() error {
-	return sh.Run("go", "generate", "./data")
-}
-
 // Protobuf protobuf related commands.
 type Protobuf mg.Namespace
 
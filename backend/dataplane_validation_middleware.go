@@ -0,0 +1,60 @@
+package backend
+
+import (
+	"context"
+	"strings"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// NewDataplaneValidationMiddleware creates a new HandlerMiddleware that validates the frames of every
+// query data response against the data plane contract using data.ValidateFrames.
+//
+// Violations are logged as warnings. If strict is true, a response with violations is additionally
+// replaced with an error response (with a plugin error source) describing the violations, so malformed
+// responses never reach dashboards.
+//
+// Validation walks every frame of every response, so this middleware is intended for development builds and tests.
+func NewDataplaneValidationMiddleware(logger log.Logger, strict bool) HandlerMiddleware {
+	return HandlerMiddlewareFunc(func(next Handler) Handler {
+		return &dataplaneValidationMiddleware{
+			BaseHandler: NewBaseHandler(next),
+			logger:      logger,
+			strict:      strict,
+		}
+	})
+}
+
+type dataplaneValidationMiddleware struct {
+	BaseHandler
+	logger log.Logger
+	strict bool
+}
+
+func (m *dataplaneValidationMiddleware) QueryData(ctx context.Context, req *QueryDataRequest) (*QueryDataResponse, error) {
+	resp, err := m.BaseHandler.QueryData(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	ctxLogger := m.logger.FromContext(ctx)
+	for refID, dr := range resp.Responses {
+		violations := data.ValidateFrames(dr.Frames)
+		if len(violations) == 0 {
+			continue
+		}
+
+		msgs := make([]string, len(violations))
+		for i, v := range violations {
+			msgs[i] = v.String()
+		}
+		ctxLogger.Warn("Query data response does not conform to the data plane contract", "refID", refID, "violations", msgs)
+
+		if m.strict {
+			resp.Responses[refID] = ErrDataResponseWithSource(StatusInternal, ErrorSourcePlugin,
+				"response does not conform to the data plane contract: "+strings.Join(msgs, "; "))
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,62 @@
+package backend_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/handlertest"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/log"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestDataplaneValidationMiddleware(t *testing.T) {
+	wideMeta := &data.FrameMeta{Type: data.FrameTypeTimeSeriesWide, TypeVersion: data.FrameTypeVersion{0, 1}}
+	valid := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{time.Unix(1, 0)}),
+		data.NewField("value", nil, []float64{1}),
+	).SetMeta(wideMeta)
+	invalid := data.NewFrame("",
+		data.NewField("value", nil, []float64{1}),
+	).SetMeta(wideMeta)
+
+	for _, tc := range []struct {
+		name        string
+		strict      bool
+		expectError bool
+	}{
+		{name: "non strict keeps responses", strict: false, expectError: false},
+		{name: "strict replaces invalid responses", strict: true, expectError: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cdt := handlertest.NewHandlerMiddlewareTest(t,
+				handlertest.WithMiddlewares(backend.NewDataplaneValidationMiddleware(log.NewNullLogger(), tc.strict)),
+			)
+			cdt.TestHandler.QueryDataFunc = func(_ context.Context, _ *backend.QueryDataRequest) (*backend.QueryDataResponse, error) {
+				return &backend.QueryDataResponse{
+					Responses: backend.Responses{
+						"A": backend.DataResponse{Frames: data.Frames{valid}},
+						"B": backend.DataResponse{Frames: data.Frames{invalid}},
+					},
+				}, nil
+			}
+
+			resp, err := cdt.MiddlewareHandler.QueryData(context.Background(), &backend.QueryDataRequest{})
+			require.NoError(t, err)
+			require.NoError(t, resp.Responses["A"].Error)
+			require.Len(t, resp.Responses["A"].Frames, 1)
+
+			if !tc.expectError {
+				require.NoError(t, resp.Responses["B"].Error)
+				require.Len(t, resp.Responses["B"].Frames, 1)
+				return
+			}
+			require.ErrorContains(t, resp.Responses["B"].Error, "data plane contract")
+			require.Equal(t, backend.ErrorSourcePlugin, resp.Responses["B"].ErrorSource)
+			require.Empty(t, resp.Responses["B"].Frames)
+		})
+	}
+}
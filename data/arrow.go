@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/arrio"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
 	"github.com/apache/arrow-go/v18/arrow/ipc"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/mattetti/filebuffer"
@@ -215,6 +218,28 @@ func buildArrowColumns(f *Frame, arrowFields []arrow.Field) ([]arrow.Column, err
 		case *nullableEnumVector:
 			columns[fieldIdx] = *buildNullableEnumColumn(pool, arrowFields[fieldIdx], v)
 
+		case *decimalVector:
+			columns[fieldIdx] = *buildDecimalColumn(pool, arrowFields[fieldIdx], v)
+		case *nullableDecimalVector:
+			columns[fieldIdx] = *buildNullableDecimalColumn(pool, arrowFields[fieldIdx], v)
+
+		case *durationVector:
+			columns[fieldIdx] = *buildDurationColumn(pool, arrowFields[fieldIdx], v)
+		case *nullableDurationVector:
+			columns[fieldIdx] = *buildNullableDurationColumn(pool, arrowFields[fieldIdx], v)
+
+		case *arrowVector:
+			if col, ok := v.arrowColumn(arrowFields[fieldIdx]); ok {
+				columns[fieldIdx] = *col
+				break
+			}
+			copied := &Frame{Fields: []*Field{{vector: v.vectorForArrow()}}}
+			cols, err := buildArrowColumns(copied, arrowFields[fieldIdx:fieldIdx+1])
+			if err != nil {
+				return nil, err
+			}
+			columns[fieldIdx] = cols[0]
+
 		default:
 			return nil, fmt.Errorf("unsupported field vector type for conversion to arrow: %T", v)
 		}
@@ -244,7 +269,7 @@ func buildArrowSchema(f *Frame, fs []arrow.Field) (*arrow.Schema, error) {
 // Vector primitives.
 // nolint:gocyclo
 func fieldToArrow(f *Field) (arrow.DataType, bool, error) {
-	switch f.vector.(type) {
+	switch v := f.vector.(type) {
 	case *stringVector:
 		return &arrow.StringType{}, false, nil
 	case *nullableStringVector:
@@ -317,11 +342,85 @@ func fieldToArrow(f *Field) (arrow.DataType, bool, error) {
 	case *nullableJsonRawMessageVector:
 		return &arrow.BinaryType{}, true, nil
 
+	case *decimalVector:
+		t, err := decimalArrowType(f.vector)
+		return t, false, err
+	case *nullableDecimalVector:
+		t, err := decimalArrowType(f.vector)
+		return t, true, err
+
+	case *durationVector:
+		return &arrow.DurationType{Unit: arrow.Nanosecond}, false, nil
+	case *nullableDurationVector:
+		return &arrow.DurationType{Unit: arrow.Nanosecond}, true, nil
+
+	case *arrowVector:
+		if v.ft.NonNullableType() == FieldTypeDecimal {
+			// the precision and scale of decimals depend on the values.
+			if v.copied == nil {
+				return v.arr.DataType(), v.ft.Nullable(), nil
+			}
+			return fieldToArrow(&Field{vector: v.copied})
+		}
+		return fieldToArrow(&Field{vector: NewFieldFromFieldType(f.Type(), 0).vector})
+
 	default:
 		return nil, false, fmt.Errorf("unsupported type for conversion to arrow: %T", f.vector)
 	}
 }
 
+// decimalArrowType returns the arrow decimal type that holds the values of a decimal vector: the
+// scale is the largest scale of the values, and the precision is the number of digits the values need at that scale.
+func decimalArrowType(v vector) (arrow.DataType, error) {
+	var scale int32
+	for i := 0; i < v.Len(); i++ {
+		if d, ok := v.ConcreteAt(i); ok && d.(Decimal).scale > scale {
+			scale = d.(Decimal).scale
+		}
+	}
+	precision := scale
+	if precision < 1 {
+		precision = 1
+	}
+	for i := 0; i < v.Len(); i++ {
+		if d, ok := v.ConcreteAt(i); ok {
+			if p := d.(Decimal).precision() + scale - d.(Decimal).scale; p > precision {
+				precision = p
+			}
+		}
+	}
+
+	switch {
+	case precision <= decimal128.MaxPrecision:
+		return &arrow.Decimal128Type{Precision: precision, Scale: scale}, nil
+	case precision <= decimal256.MaxPrecision:
+		return &arrow.Decimal256Type{Precision: precision, Scale: scale}, nil
+	}
+	return nil, fmt.Errorf("decimal values need a precision of %d, more than the maximum of %d supported by arrow", precision, decimal256.MaxPrecision)
+}
+
+// arrowDecimalAt returns a function that reads the values of a decimal128 or decimal256 array as Decimals.
+func arrowDecimalAt(arr arrow.Array) (func(i int) Decimal, error) {
+	var scale int32
+	var valueAt func(i int) *big.Int
+	switch a := arr.(type) {
+	case *array.Decimal128:
+		scale = a.DataType().(*arrow.Decimal128Type).Scale
+		valueAt = func(i int) *big.Int { return a.Value(i).BigInt() }
+	case *array.Decimal256:
+		scale = a.DataType().(*arrow.Decimal256Type).Scale
+		valueAt = func(i int) *big.Int { return a.Value(i).BigInt() }
+	default:
+		return nil, fmt.Errorf("unsupported arrow array %T for conversion", arr)
+	}
+	if scale < -MaxDecimalPrecision || scale > MaxDecimalPrecision {
+		return nil, fmt.Errorf("unsupported arrow decimal scale %d, the maximum is %d", scale, MaxDecimalPrecision)
+	}
+	return func(i int) Decimal {
+		return NewDecimal(valueAt(i), scale)
+	}, nil
+}
+
 func getMDKey(key string, metaData arrow.Metadata) (string, bool) {
 	idx := metaData.FindKey(key)
 	if idx < 0 {
@@ -462,6 +561,18 @@ func initializeFrameField(field arrow.Field, idx int, nullable []bool, sdkField
 			break
 		}
 		sdkField.vector = newJsonRawMessageVector(0)
+	case arrow.DECIMAL128, arrow.DECIMAL256:
+		if nullable[idx] {
+			sdkField.vector = newNullableDecimalVector(0)
+			break
+		}
+		sdkField.vector = newDecimalVector(0)
+	case arrow.DURATION:
+		if nullable[idx] {
+			sdkField.vector = newNullableDurationVector(0)
+			break
+		}
+		sdkField.vector = newDurationVector(0)
 	default:
 		return fmt.Errorf("unsupported conversion from arrow to sdk type for arrow type %v", field.Type.ID().String())
 	}
@@ -739,6 +850,40 @@ func parseColumn(col arrow.Array, i int, nullable []bool, frame *Frame) error {
 			r := json.RawMessage(v.Value(sIdx))
 			frame.Fields[i].vector.Append(r)
 		}
+	case arrow.DECIMAL128, arrow.DECIMAL256:
+		valueAt, err := arrowDecimalAt(col)
+		if err != nil {
+			return err
+		}
+		for dIdx := 0; dIdx < col.Len(); dIdx++ {
+			if nullable[i] {
+				if col.IsNull(dIdx) {
+					var nd *Decimal
+					frame.Fields[i].vector.Append(nd)
+					continue
+				}
+				d := valueAt(dIdx)
+				frame.Fields[i].vector.Append(&d)
+				continue
+			}
+			frame.Fields[i].vector.Append(valueAt(dIdx))
+		}
+	case arrow.DURATION:
+		v := array.NewDurationData(col.Data())
+		unit := v.DataType().(*arrow.DurationType).Unit.Multiplier()
+		for dIdx := 0; dIdx < v.Len(); dIdx++ {
+			d := time.Duration(v.Value(dIdx)) * unit
+			if nullable[i] {
+				if v.IsNull(dIdx) {
+					var nd *time.Duration
+					frame.Fields[i].vector.Append(nd)
+					continue
+				}
+				frame.Fields[i].vector.Append(&d)
+				continue
+			}
+			frame.Fields[i].vector.Append(d)
+		}
 	default:
 		return fmt.Errorf("unsupported arrow type %s for conversion", col.DataType().ID())
 	}
@@ -805,6 +950,102 @@ func UnmarshalArrowFrame(b []byte) (*Frame, error) {
 	return frame, nil
 }
 
+// FromArrowRecordZeroCopy converts an Arrow record batch into a Frame like FromArrowRecord, but the
+// Fields read their values directly from the record's columns instead of copying them into Go slices.
+//
+// The columns are copied into Go slices the first time a Field is modified (copy-on-write), so
+// Fields that are only read and passed through (for example by encoding the Frame back to Arrow with
+// MarshalArrow) are never copied. The record's memory must be managed by the Go garbage collector
+// (as it is with memory.DefaultAllocator) since the Frame keeps referencing it after the caller releases the record.
+func FromArrowRecordZeroCopy(record arrow.Record) (*Frame, error) { //nolint:staticcheck // SA1019: Using deprecated Record type for backwards compatibility
+	schema := record.Schema()
+	frame := &Frame{}
+	if err := populateFrameFromSchema(schema, frame); err != nil {
+		return nil, err
+	}
+
+	if _, err := initializeFrameFields(schema, frame); err != nil {
+		return nil, err
+	}
+
+	for i, field := range frame.Fields {
+		v, err := newArrowVector(record.Column(i), field.Type())
+		if err != nil {
+			return nil, err
+		}
+		field.vector = v
+	}
+	return frame, nil
+}
+
+// UnmarshalArrowFrameZeroCopy converts a byte representation of an arrow table to a Frame like
+// UnmarshalArrowFrame, but the Fields read their values directly from the decoded Arrow columns
+// (see FromArrowRecordZeroCopy). If the table has more than one record batch, the batches of each
+// column are concatenated once.
+func UnmarshalArrowFrameZeroCopy(b []byte) (*Frame, error) {
+	fB := filebuffer.New(b)
+	fR, err := ipc.NewFileReader(fB)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = fR.Close() }()
+
+	schema := fR.Schema()
+	frame := &Frame{}
+	if err := populateFrameFromSchema(schema, frame); err != nil {
+		return nil, err
+	}
+
+	if _, err := initializeFrameFields(schema, frame); err != nil {
+		return nil, err
+	}
+
+	chunks := make([][]arrow.Array, len(frame.Fields))
+	defer func() {
+		for _, colChunks := range chunks {
+			for _, chunk := range colChunks {
+				chunk.Release()
+			}
+		}
+	}()
+	for i := 0; i < fR.NumRecords(); i++ {
+		record, err := fR.RecordBatchAt(i)
+		if err != nil {
+			return nil, err
+		}
+		for c := range frame.Fields {
+			col := record.Column(c)
+			col.Retain()
+			chunks[c] = append(chunks[c], col)
+		}
+		record.Release()
+	}
+
+	for i, field := range frame.Fields {
+		var arr arrow.Array
+		switch len(chunks[i]) {
+		case 0:
+			arr = array.MakeArrayOfNull(memory.DefaultAllocator, schema.Field(i).Type, 0)
+		case 1:
+			arr = chunks[i][0]
+			arr.Retain()
+		default:
+			if arr, err = array.Concatenate(chunks[i], memory.DefaultAllocator); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := newArrowVector(arr, field.Type())
+		arr.Release() // the vector retains the array
+		if err != nil {
+			return nil, err
+		}
+		field.vector = v
+	}
+
+	return frame, nil
+}
+
 // ToJSONString calls json.Marshal on val and returns it as a string. An
 // error is returned if json.Marshal errors.
 func toJSONString(val interface{}) (string, error) {
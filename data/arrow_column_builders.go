@@ -3,6 +3,8 @@ package data
 import (
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 )
 
@@ -489,3 +491,78 @@ func buildEnumColumn(pool memory.Allocator, field arrow.Field, vec *enumVector)
 
 	return arrow.NewColumn(field, chunked)
 }
+
+func buildDecimalColumn(pool memory.Allocator, field arrow.Field, vec *decimalVector) *arrow.Column {
+	values := make(nullableDecimalVector, len(*vec))
+	for i := range *vec {
+		values[i] = &(*vec)[i]
+	}
+	return buildNullableDecimalColumn(pool, field, &values)
+}
+
+func buildNullableDecimalColumn(pool memory.Allocator, field arrow.Field, vec *nullableDecimalVector) *arrow.Column {
+	var arr arrow.Array
+	switch t := field.Type.(type) {
+	case *arrow.Decimal128Type:
+		builder := array.NewDecimal128Builder(pool, t)
+		defer builder.Release()
+
+		for _, v := range *vec {
+			if v == nil {
+				builder.AppendNull()
+				continue
+			}
+			builder.Append(decimal128.FromBigInt(v.rescale(t.Scale)))
+		}
+		arr = builder.NewArray()
+	case *arrow.Decimal256Type:
+		builder := array.NewDecimal256Builder(pool, t)
+		defer builder.Release()
+
+		for _, v := range *vec {
+			if v == nil {
+				builder.AppendNull()
+				continue
+			}
+			builder.Append(decimal256.FromBigInt(v.rescale(t.Scale)))
+		}
+		arr = builder.NewArray()
+	}
+
+	chunked := arrow.NewChunked(field.Type, []arrow.Array{arr})
+	defer chunked.Release()
+
+	return arrow.NewColumn(field, chunked)
+}
+
+func buildDurationColumn(pool memory.Allocator, field arrow.Field, vec *durationVector) *arrow.Column {
+	builder := array.NewDurationBuilder(pool, &arrow.DurationType{Unit: arrow.Nanosecond})
+	defer builder.Release()
+
+	for _, v := range *vec {
+		builder.Append(arrow.Duration(v))
+	}
+
+	chunked := arrow.NewChunked(field.Type, []arrow.Array{builder.NewArray()})
+	defer chunked.Release()
+
+	return arrow.NewColumn(field, chunked)
+}
+
+func buildNullableDurationColumn(pool memory.Allocator, field arrow.Field, vec *nullableDurationVector) *arrow.Column {
+	builder := array.NewDurationBuilder(pool, &arrow.DurationType{Unit: arrow.Nanosecond})
+	defer builder.Release()
+
+	for _, v := range *vec {
+		if v == nil {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(arrow.Duration(*v))
+	}
+
+	chunked := arrow.NewChunked(field.Type, []arrow.Array{builder.NewArray()})
+	defer chunked.Release()
+
+	return arrow.NewColumn(field, chunked)
+}
@@ -0,0 +1,265 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+)
+
+// arrowVector is a vector that reads its values directly from an arrow.Array instead of
+// holding them in a Go slice. It is copy-on-write: the first call that mutates the vector
+// copies the values into a regular vector of the same FieldType, which then serves all calls.
+//
+// The array is retained for the lifetime of the vector and is never released by it, so it must
+// be allocated by an allocator whose memory is managed by the Go garbage collector (such as
+// memory.DefaultAllocator).
+type arrowVector struct {
+	arr arrow.Array
+	ft  FieldType
+
+	// concreteAt returns the non-pointer value at i (see vector.ConcreteAt) without checking for null.
+	concreteAt func(i int) interface{}
+	// pointerTo returns a pointer to a copy of the value at i, or a typed nil pointer if the value is null.
+	pointerTo func(i int) interface{}
+	// zero is the zero value returned by ConcreteAt for null values.
+	zero interface{}
+
+	// copied is set on the first mutation and from then on serves all calls.
+	copied vector
+}
+
+// newArrowVector returns a vector of FieldType ft backed by arr.
+// An error is returned if the type of arr does not hold values of ft.
+// nolint:gocyclo
+func newArrowVector(arr arrow.Array, ft FieldType) (*arrowVector, error) {
+	v := &arrowVector{arr: arr, ft: ft}
+	var item FieldType
+	switch a := arr.(type) {
+	case *array.Int8:
+		item = FieldTypeInt8
+		setArrowAccessors(v, a.Value)
+	case *array.Int16:
+		item = FieldTypeInt16
+		setArrowAccessors(v, a.Value)
+	case *array.Int32:
+		item = FieldTypeInt32
+		setArrowAccessors(v, a.Value)
+	case *array.Int64:
+		item = FieldTypeInt64
+		setArrowAccessors(v, a.Value)
+	case *array.Uint8:
+		item = FieldTypeUint8
+		setArrowAccessors(v, a.Value)
+	case *array.Uint16:
+		item = FieldTypeUint16
+		if ft.NonNullableType() == FieldTypeEnum {
+			item = FieldTypeEnum
+			setArrowAccessors(v, func(i int) EnumItemIndex { return EnumItemIndex(a.Value(i)) })
+			break
+		}
+		setArrowAccessors(v, a.Value)
+	case *array.Uint32:
+		item = FieldTypeUint32
+		setArrowAccessors(v, a.Value)
+	case *array.Uint64:
+		item = FieldTypeUint64
+		setArrowAccessors(v, a.Value)
+	case *array.Float32:
+		item = FieldTypeFloat32
+		setArrowAccessors(v, a.Value)
+	case *array.Float64:
+		item = FieldTypeFloat64
+		setArrowAccessors(v, a.Value)
+	case *array.String:
+		item = FieldTypeString
+		setArrowAccessors(v, a.Value)
+	case *array.StringView:
+		item = FieldTypeString
+		setArrowAccessors(v, a.Value)
+	case *array.Boolean:
+		item = FieldTypeBool
+		setArrowAccessors(v, a.Value)
+	case *array.Timestamp:
+		item = FieldTypeTime
+		setArrowAccessors(v, func(i int) time.Time {
+			return time.Unix(0, int64(a.Value(i))) // nanosecond assumption
+		})
+	case *array.Binary:
+		item = FieldTypeJSON
+		setArrowAccessors(v, func(i int) json.RawMessage {
+			// the bytes are cloned since a json.RawMessage is mutable.
+			return json.RawMessage(bytes.Clone(a.Value(i)))
+		})
+	case *array.Decimal128, *array.Decimal256:
+		item = FieldTypeDecimal
+		valueAt, err := arrowDecimalAt(a)
+		if err != nil {
+			return nil, err
+		}
+		setArrowAccessors(v, valueAt)
+	case *array.Duration:
+		item = FieldTypeDuration
+		unit := a.DataType().(*arrow.DurationType).Unit.Multiplier()
+		setArrowAccessors(v, func(i int) time.Duration {
+			return time.Duration(a.Value(i)) * unit
+		})
+	default:
+		return nil, fmt.Errorf("unsupported arrow type %s for conversion", arr.DataType().ID())
+	}
+
+	if item != ft.NonNullableType() {
+		return nil, fmt.Errorf("arrow type %s can not hold values of field type %s", arr.DataType().ID(), ft.ItemTypeString())
+	}
+	arr.Retain()
+	return v, nil
+}
+
+func setArrowAccessors[T any](v *arrowVector, valueAt func(i int) T) {
+	var zero T
+	v.zero = zero
+	v.concreteAt = func(i int) interface{} {
+		return valueAt(i)
+	}
+	v.pointerTo = func(i int) interface{} {
+		if v.arr.IsNull(i) {
+			var p *T
+			return p
+		}
+		val := valueAt(i)
+		return &val
+	}
+}
+
+// copyValues returns a regular vector holding a copy of the values of the array.
+func (v *arrowVector) copyValues() vector {
+	c := NewFieldFromFieldType(v.ft, v.arr.Len()).vector
+	for i := 0; i < v.arr.Len(); i++ {
+		if v.arr.IsNull(i) {
+			continue
+		}
+		c.SetConcrete(i, v.concreteAt(i))
+	}
+	return c
+}
+
+// mutable returns the vector that mutations are applied to, copying the values of the array on first use.
+func (v *arrowVector) mutable() vector {
+	if v.copied == nil {
+		v.copied = v.copyValues()
+	}
+	return v.copied
+}
+
+func (v *arrowVector) Set(idx int, i interface{}) {
+	v.mutable().Set(idx, i)
+}
+
+func (v *arrowVector) Append(i interface{}) {
+	v.mutable().Append(i)
+}
+
+func (v *arrowVector) Extend(i int) {
+	v.mutable().Extend(i)
+}
+
+func (v *arrowVector) Grow(n int) {
+	v.mutable().Grow(n)
+}
+
+func (v *arrowVector) At(i int) interface{} {
+	if v.copied != nil {
+		return v.copied.At(i)
+	}
+	if v.ft.Nullable() {
+		return v.pointerTo(i)
+	}
+	return v.concreteAt(i)
+}
+
+func (v *arrowVector) NilAt(i int) bool {
+	if v.copied != nil {
+		return v.copied.NilAt(i)
+	}
+	return v.ft.Nullable() && v.arr.IsNull(i)
+}
+
+func (v *arrowVector) Len() int {
+	if v.copied != nil {
+		return v.copied.Len()
+	}
+	return v.arr.Len()
+}
+
+func (v *arrowVector) Cap() int {
+	if v.copied != nil {
+		return v.copied.Cap()
+	}
+	return v.arr.Len()
+}
+
+func (v *arrowVector) Type() FieldType {
+	return v.ft
+}
+
+// PointerAt returns a pointer that can be used to modify the value at i, so it copies the values of the array.
+func (v *arrowVector) PointerAt(i int) interface{} {
+	return v.mutable().PointerAt(i)
+}
+
+func (v *arrowVector) CopyAt(i int) interface{} {
+	if v.copied != nil {
+		return v.copied.CopyAt(i)
+	}
+	// values read from the array are already copies.
+	return v.At(i)
+}
+
+func (v *arrowVector) ConcreteAt(i int) (interface{}, bool) {
+	if v.copied != nil {
+		return v.copied.ConcreteAt(i)
+	}
+	if v.NilAt(i) {
+		return v.zero, false
+	}
+	return v.concreteAt(i), true
+}
+
+func (v *arrowVector) SetConcrete(i int, val interface{}) {
+	v.mutable().SetConcrete(i, val)
+}
+
+func (v *arrowVector) Insert(i int, val interface{}) {
+	v.mutable().Insert(i, val)
+}
+
+func (v *arrowVector) Delete(i int) {
+	v.mutable().Delete(i)
+}
+
+func (v *arrowVector) Clear() {
+	v.mutable().Clear()
+}
+
+// arrowColumn returns the column holding the values of the vector for the arrow field. If the vector has not
+// been mutated and its array is of the field's type, the column wraps the array without copying it.
+func (v *arrowVector) arrowColumn(field arrow.Field) (*arrow.Column, bool) {
+	if v.copied != nil || !arrow.TypeEqual(v.arr.DataType(), field.Type) {
+		return nil, false
+	}
+	chunked := arrow.NewChunked(field.Type, []arrow.Array{v.arr})
+	defer chunked.Release()
+	return arrow.NewColumn(field, chunked), true
+}
+
+// vectorForArrow returns a regular vector with the values of the vector, for building arrow columns when
+// the array can not be used directly.
+func (v *arrowVector) vectorForArrow() vector {
+	if v.copied != nil {
+		return v.copied
+	}
+	return v.copyValues()
+}
@@ -0,0 +1,119 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestUnmarshalArrowFrameZeroCopy(t *testing.T) {
+	df := goldenDF()
+	b, err := df.MarshalArrow()
+	require.NoError(t, err)
+
+	newDf, err := data.UnmarshalArrowFrameZeroCopy(b)
+	require.NoError(t, err)
+
+	if diff := cmp.Diff(df, newDf, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	for i, field := range df.Fields {
+		for rowIdx := 0; rowIdx < field.Len(); rowIdx++ {
+			_, expectedOk := field.ConcreteAt(rowIdx)
+			_, actualOk := newDf.Fields[i].ConcreteAt(rowIdx)
+			require.Equal(t, expectedOk, actualOk, "field %d row %d", i, rowIdx)
+			require.Equal(t, field.NilAt(rowIdx), newDf.Fields[i].NilAt(rowIdx), "field %d row %d", i, rowIdx)
+		}
+	}
+
+	// encoding the frame again reuses the arrow columns
+	reencoded, err := newDf.MarshalArrow()
+	require.NoError(t, err)
+	decoded, err := data.UnmarshalArrowFrame(reencoded)
+	require.NoError(t, err)
+	if diff := cmp.Diff(df, decoded, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestArrowBackedFieldCopyOnWrite(t *testing.T) {
+	frame := data.NewFrame("",
+		data.NewField("value", nil, []float64{1, 2, 3}),
+		data.NewField("nullable", nil, []*string{stringPtr("a"), nil, stringPtr("c")}),
+	)
+	table, err := data.FrameToArrowTable(frame)
+	require.NoError(t, err)
+	defer table.Release()
+
+	tr := array.NewTableReader(table, -1)
+	defer tr.Release()
+	require.True(t, tr.Next())
+
+	zc, err := data.FromArrowRecordZeroCopy(tr.Record()) //nolint:staticcheck // SA1019: Using deprecated Record() API for backwards compatibility
+	require.NoError(t, err)
+	require.Equal(t, data.FieldTypeNullableString, zc.Fields[1].Type())
+	require.Equal(t, stringPtr("a"), zc.Fields[1].At(0))
+	require.True(t, zc.Fields[1].NilAt(1))
+
+	zc.Fields[0].Set(1, 20.0)
+	zc.Fields[0].Append(4.0)
+	require.Equal(t, 20.0, zc.Fields[0].At(1))
+	require.Equal(t, 4, zc.Fields[0].Len())
+
+	// the record is not modified
+	v, ok := tr.Record().Column(0).(*array.Float64) //nolint:staticcheck // SA1019: Using deprecated Record() API for backwards compatibility
+	require.True(t, ok)
+	require.Equal(t, []float64{1, 2, 3}, v.Float64Values())
+
+	// pointers returned by At for nullable fields are copies
+	s := zc.Fields[1].At(0).(*string)
+	*s = "changed"
+	require.Equal(t, stringPtr("a"), zc.Fields[1].At(0))
+
+	zc.Fields[1].Set(1, stringPtr("b"))
+	expected := data.NewFrame("",
+		data.NewField("value", nil, []float64{1, 20, 3, 4}),
+		data.NewField("nullable", nil, []*string{stringPtr("a"), stringPtr("b"), stringPtr("c")}),
+	)
+	zc.Fields[0].Delete(3)
+	expected.Fields[0].Delete(3)
+	if diff := cmp.Diff(expected, zc, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func benchmarkArrowPassThrough(b *testing.B, unmarshal func([]byte) (*data.Frame, error)) {
+	values := make([]float64, 1_000_000)
+	for i := range values {
+		values[i] = float64(i)
+	}
+	encoded, err := data.NewFrame("", data.NewField("value", nil, values)).MarshalArrow()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		frame, err := unmarshal(encoded)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := frame.MarshalArrow(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkArrowPassThroughCopy(b *testing.B) {
+	benchmarkArrowPassThrough(b, data.UnmarshalArrowFrame)
+}
+
+func BenchmarkArrowPassThroughZeroCopy(b *testing.B) {
+	benchmarkArrowPassThrough(b, data.UnmarshalArrowFrameZeroCopy)
+}
@@ -176,6 +176,42 @@ var StringToNullableFloat64 = data.FieldConverter{
 	},
 }
 
+// StringToNullableInt64 parses a base 10 int64 value from a string.
+var StringToNullableInt64 = data.FieldConverter{
+	OutputFieldType: data.FieldTypeNullableInt64,
+	Converter: func(v interface{}) (interface{}, error) {
+		var ptr *int64
+		if v == nil {
+			return ptr, nil
+		}
+		val, ok := v.(string)
+		if !ok {
+			return ptr, toConversionError("string", v)
+		}
+		iV, err := strconv.ParseInt(val, 10, 64)
+		ptr = &iV
+		return ptr, err
+	},
+}
+
+// StringToNullableBool parses a bool value from a string using strconv.ParseBool.
+var StringToNullableBool = data.FieldConverter{
+	OutputFieldType: data.FieldTypeNullableBool,
+	Converter: func(v interface{}) (interface{}, error) {
+		var ptr *bool
+		if v == nil {
+			return ptr, nil
+		}
+		val, ok := v.(string)
+		if !ok {
+			return ptr, toConversionError("string", v)
+		}
+		bV, err := strconv.ParseBool(val)
+		ptr = &bV
+		return ptr, err
+	},
+}
+
 // Float64EpochSecondsToTime converts a numeric seconds to time.Time.
 var Float64EpochSecondsToTime = data.FieldConverter{
 	OutputFieldType: data.FieldTypeTime,
@@ -47,3 +47,20 @@ func TestJSONConversions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 12.34, val)
 }
+
+func TestStringParsingConversions(t *testing.T) {
+	val, err := converters.StringToNullableInt64.Converter("42")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), *(val.(*int64)))
+
+	_, err = converters.StringToNullableInt64.Converter("4.2")
+	require.Error(t, err)
+
+	val, err = converters.StringToNullableBool.Converter("true")
+	require.NoError(t, err)
+	require.True(t, *(val.(*bool)))
+
+	val, err = converters.StringToNullableBool.Converter(nil)
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
@@ -0,0 +1,254 @@
+package counter
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// Options configure the computation of Rate, Increase and Derivative.
+type Options struct {
+	// Window is the time range before each point whose points are used to compute the value at the
+	// point, like the range of a PromQL range vector selector: the points after the point's time
+	// minus Window, up to and including the point. A value needs at least two points in its window.
+	//
+	// When Window is 0, the value at each point is computed from the point and the previous point of
+	// its series, and is null for the first point.
+	Window time.Duration
+
+	// Extrapolate extrapolates the increase of Rate and Increase with a Window to the whole window,
+	// like PromQL does, when the points do not cover it. Otherwise the rate is the increase divided
+	// by the time between the first and last point of the window.
+	Extrapolate bool
+}
+
+// point is a non-null value of a series, at a time in Unix nanoseconds.
+type point struct {
+	t int64
+	v float64
+}
+
+// Rate returns the per-second rate of increase of the counters of frame, with counter resets taken into account.
+func Rate(frame *data.Frame, opts Options) (*data.Frame, error) {
+	return apply(frame, opts, func(points []point, start, end int64) (float64, bool) {
+		if opts.Window == 0 {
+			return counterIncrease(points) / seconds(points[1].t-points[0].t), true
+		}
+		return extrapolatedIncrease(points, start, end, opts.Extrapolate, true)
+	})
+}
+
+// Increase returns the increase of the counters of frame, with counter resets taken into account.
+// With a Window and Extrapolate, the increase is extrapolated to the Window and so is not always an integer.
+func Increase(frame *data.Frame, opts Options) (*data.Frame, error) {
+	return apply(frame, opts, func(points []point, start, end int64) (float64, bool) {
+		if opts.Window == 0 {
+			return counterIncrease(points), true
+		}
+		return extrapolatedIncrease(points, start, end, opts.Extrapolate, false)
+	})
+}
+
+// Derivative returns the per-second derivative of the gauges of frame. With a Window it is the slope
+// of the simple linear regression of the points of the window, like the PromQL deriv function.
+// Decreasing values are not counter resets, so the derivative may be negative. opts.Extrapolate is ignored.
+func Derivative(frame *data.Frame, opts Options) (*data.Frame, error) {
+	return apply(frame, opts, func(points []point, _, _ int64) (float64, bool) {
+		if opts.Window == 0 {
+			return (points[1].v - points[0].v) / seconds(points[1].t-points[0].t), true
+		}
+		return slope(points)
+	})
+}
+
+// valueFunc computes the value of the points of the window from start, exclusive, to end, inclusive.
+// It is only called with at least two points.
+type valueFunc func(points []point, start, end int64) (float64, bool)
+
+// apply computes fn for each point of each series of frame.
+func apply(frame *data.Frame, opts Options, fn valueFunc) (*data.Frame, error) {
+	if opts.Window < 0 {
+		return nil, fmt.Errorf("invalid negative window %s", opts.Window)
+	}
+	schema := frame.TimeSeriesSchema()
+	if schema.Type == data.TimeSeriesTypeNot {
+		return nil, fmt.Errorf("frame %q is not a time series", frame.Name)
+	}
+	rowLen, err := frame.RowLen()
+	if err != nil {
+		return nil, err
+	}
+	times, err := schema.UnixNanoTimes(frame)
+	if err != nil {
+		return nil, err
+	}
+	series, err := schema.SeriesRows(frame)
+	if err != nil {
+		return nil, err
+	}
+
+	isValue := make(map[int]bool, len(schema.ValueIndices))
+	for _, idx := range schema.ValueIndices {
+		field := frame.Fields[idx]
+		if !field.Type().Numeric() {
+			return nil, fmt.Errorf("field %q of type %s is not numeric", field.Name, field.Type())
+		}
+		isValue[idx] = true
+	}
+
+	out := frame.CopyWithoutFields()
+	for fieldIdx, field := range frame.Fields {
+		if !isValue[fieldIdx] {
+			out.Fields = append(out.Fields, field.Copy())
+			continue
+		}
+		result := data.NewFieldFromFieldType(data.FieldTypeNullableFloat64, rowLen)
+		result.Name = field.Name
+		if field.Labels != nil {
+			result.Labels = field.Labels.Copy()
+		}
+		result.Config = field.Config
+		for _, rows := range series {
+			if err := applySeries(field, result, rows, times, opts.Window, fn); err != nil {
+				return nil, err
+			}
+		}
+		out.Fields = append(out.Fields, result)
+	}
+	return out, nil
+}
+
+// applySeries sets the values of the rows of a series in result.
+func applySeries(field, result *data.Field, rows []int, times []int64, window time.Duration, fn valueFunc) error {
+	points := make([]point, 0, len(rows))
+	pointRows := make([]int, 0, len(rows))
+	for _, rowIdx := range rows {
+		v, err := field.NullableFloatAt(rowIdx)
+		if err != nil {
+			return err
+		}
+		if v == nil || math.IsNaN(*v) {
+			continue
+		}
+		points = append(points, point{t: times[rowIdx], v: *v})
+		pointRows = append(pointRows, rowIdx)
+	}
+
+	if window == 0 {
+		for i := 1; i < len(points); i++ {
+			if points[i].t == points[i-1].t {
+				continue
+			}
+			if v, ok := fn(points[i-1:i+1], points[i-1].t, points[i].t); ok {
+				result.SetConcrete(pointRows[i], v)
+			}
+		}
+		return nil
+	}
+
+	// the window of each row of the series, including rows with a null value, is points[first:last]
+	first, last := 0, 0
+	for _, rowIdx := range rows {
+		end := times[rowIdx]
+		start := end - int64(window)
+		for last < len(points) && points[last].t <= end {
+			last++
+		}
+		for first < last && points[first].t <= start {
+			first++
+		}
+		if last-first < 2 {
+			continue
+		}
+		if v, ok := fn(points[first:last], start, end); ok {
+			result.SetConcrete(rowIdx, v)
+		}
+	}
+	return nil
+}
+
+// counterIncrease returns the increase of the counter points, adding the value before each reset.
+func counterIncrease(points []point) float64 {
+	increase := points[len(points)-1].v - points[0].v
+	for i := 1; i < len(points); i++ {
+		if points[i].v < points[i-1].v {
+			increase += points[i-1].v
+		}
+	}
+	return increase
+}
+
+// extrapolatedIncrease returns the increase, or the per-second rate if isRate, of the counter points
+// of the window from start to end, with the extrapolation of the PromQL rate and increase functions.
+func extrapolatedIncrease(points []point, start, end int64, extrapolate, isRate bool) (float64, bool) {
+	first, last := points[0], points[len(points)-1]
+	increase := counterIncrease(points)
+	sampledInterval := seconds(last.t - first.t)
+	if sampledInterval == 0 {
+		return 0, false
+	}
+	if !extrapolate {
+		if isRate {
+			return increase / sampledInterval, true
+		}
+		return increase, true
+	}
+
+	durationToStart := seconds(first.t - start)
+	durationToEnd := seconds(end - last.t)
+	averageDurationBetweenPoints := sampledInterval / float64(len(points)-1)
+
+	// a counter can not be extrapolated below zero
+	if increase > 0 && first.v >= 0 {
+		if durationToZero := sampledInterval * (first.v / increase); durationToZero < durationToStart {
+			durationToStart = durationToZero
+		}
+	}
+
+	// extrapolate to the window boundaries unless the points are too far from them, in which case
+	// the series probably started or ended in the window, and extrapolate half an interval instead.
+	extrapolationThreshold := averageDurationBetweenPoints * 1.1
+	extrapolateToInterval := sampledInterval
+	if durationToStart < extrapolationThreshold {
+		extrapolateToInterval += durationToStart
+	} else {
+		extrapolateToInterval += averageDurationBetweenPoints / 2
+	}
+	if durationToEnd < extrapolationThreshold {
+		extrapolateToInterval += durationToEnd
+	} else {
+		extrapolateToInterval += averageDurationBetweenPoints / 2
+	}
+
+	increase *= extrapolateToInterval / sampledInterval
+	if isRate {
+		return increase / seconds(end-start), true
+	}
+	return increase, true
+}
+
+// slope returns the per-second slope of the simple linear regression of the points.
+func slope(points []point) (float64, bool) {
+	// times are relative to the first point to keep precision
+	var sumX, sumY, sumXY, sumX2 float64
+	for _, p := range points {
+		x := seconds(p.t - points[0].t)
+		sumX += x
+		sumY += p.v
+		sumXY += x * p.v
+		sumX2 += x * x
+	}
+	n := float64(len(points))
+	covXY := sumXY - sumX*sumY/n
+	varX := sumX2 - sumX*sumX/n
+	if varX == 0 {
+		return 0, false
+	}
+	return covXY / varX, true
+}
+
+func seconds(nanos int64) float64 {
+	return float64(nanos) / float64(time.Second)
+}
@@ -0,0 +1,144 @@
+package counter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/counter"
+)
+
+func ptr(f float64) *float64 {
+	return &f
+}
+
+func seconds(s ...int64) []time.Time {
+	times := make([]time.Time, len(s))
+	for i, v := range s {
+		times[i] = time.Unix(v, 0)
+	}
+	return times
+}
+
+// counterFrame is a counter that is reset between 20s and 30s.
+func counterFrame() *data.Frame {
+	return data.NewFrame("requests",
+		data.NewField("time", nil, seconds(0, 10, 20, 30, 40)),
+		data.NewField("total", data.Labels{"host": "a"}, []int64{0, 10, 20, 5, 15}).SetConfig(&data.FieldConfig{Unit: "short"}),
+	)
+}
+
+func expectedFrame(values ...*float64) *data.Frame {
+	return data.NewFrame("requests",
+		data.NewField("time", nil, seconds(0, 10, 20, 30, 40)),
+		data.NewField("total", data.Labels{"host": "a"}, values).SetConfig(&data.FieldConfig{Unit: "short"}),
+	)
+}
+
+func TestCounterFunctions(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(*data.Frame, counter.Options) (*data.Frame, error)
+		opts     counter.Options
+		expected *data.Frame
+	}{
+		{
+			name:     "rate",
+			fn:       counter.Rate,
+			expected: expectedFrame(nil, ptr(1), ptr(1), ptr(0.5), ptr(1)),
+		},
+		{
+			name:     "increase",
+			fn:       counter.Increase,
+			expected: expectedFrame(nil, ptr(10), ptr(10), ptr(5), ptr(10)),
+		},
+		{
+			name:     "derivative",
+			fn:       counter.Derivative,
+			expected: expectedFrame(nil, ptr(1), ptr(1), ptr(-1.5), ptr(1)),
+		},
+		{
+			name:     "rate with window",
+			fn:       counter.Rate,
+			opts:     counter.Options{Window: 20 * time.Second},
+			expected: expectedFrame(nil, ptr(1), ptr(1), ptr(0.5), ptr(1)),
+		},
+		{
+			name:     "increase with window",
+			fn:       counter.Increase,
+			opts:     counter.Options{Window: 30 * time.Second},
+			expected: expectedFrame(nil, ptr(10), ptr(20), ptr(15), ptr(15)),
+		},
+		{
+			name: "rate with window and extrapolation",
+			fn:   counter.Rate,
+			opts: counter.Options{Window: 20 * time.Second, Extrapolate: true},
+			// at 10s the counter can not be extrapolated below 0, at 40s only to 5s after the reset
+			expected: expectedFrame(nil, ptr(0.5), ptr(1), ptr(0.5), ptr(0.75)),
+		},
+		{
+			name:     "derivative with window",
+			fn:       counter.Derivative,
+			opts:     counter.Options{Window: 30 * time.Second},
+			expected: expectedFrame(nil, ptr(1), ptr(1), ptr(-0.25), ptr(-0.25)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := counterFrame()
+			out, err := tt.fn(input, tt.opts)
+			require.NoError(t, err)
+			if diff := cmp.Diff(tt.expected, out, data.FrameTestCompareOptions()...); diff != "" {
+				t.Errorf("Result mismatch (-want +got):\n%s", diff)
+			}
+			require.Equal(t, counterFrame(), input)
+		})
+	}
+}
+
+func TestRateLongFrame(t *testing.T) {
+	frame := data.NewFrame("",
+		data.NewField("time", nil, seconds(0, 0, 10, 10, 20)),
+		data.NewField("host", nil, []string{"a", "b", "a", "b", "a"}),
+		data.NewField("total", nil, []*float64{ptr(0), ptr(100), ptr(5), nil, ptr(15)}),
+	)
+	out, err := counter.Rate(frame, counter.Options{})
+	require.NoError(t, err)
+
+	expected := data.NewFrame("",
+		data.NewField("time", nil, seconds(0, 0, 10, 10, 20)),
+		data.NewField("host", nil, []string{"a", "b", "a", "b", "a"}),
+		data.NewField("total", nil, []*float64{nil, nil, ptr(0.5), nil, ptr(1)}),
+	)
+	if diff := cmp.Diff(expected, out, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRateDoesNotShareInput(t *testing.T) {
+	frame := counterFrame().SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide})
+	out, err := counter.Rate(frame, counter.Options{})
+	require.NoError(t, err)
+
+	out.Meta.Type = data.FrameTypeTimeSeriesLong
+	out.Fields[0].Set(0, time.Unix(100, 0))
+	require.Equal(t, data.FrameTypeTimeSeriesWide, frame.Meta.Type)
+	require.Equal(t, time.Unix(0, 0), frame.Fields[0].At(0))
+}
+
+func TestCounterErrors(t *testing.T) {
+	_, err := counter.Rate(data.NewFrame("", data.NewField("v", nil, []float64{1})), counter.Options{})
+	require.Error(t, err)
+
+	_, err = counter.Rate(data.NewFrame("",
+		data.NewField("time", nil, seconds(10, 0)),
+		data.NewField("v", nil, []float64{1, 2}),
+	), counter.Options{})
+	require.Error(t, err)
+
+	_, err = counter.Rate(counterFrame(), counter.Options{Window: -time.Second})
+	require.Error(t, err)
+}
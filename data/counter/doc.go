@@ -0,0 +1,13 @@
+// Package counter computes the per-second rate, the increase and the derivative of the series of
+// wide and long time series frames, for data sources whose upstream only exposes raw values such
+// as monotonic counters.
+//
+// Rate and Increase treat the values as counters: a value lower than the previous one is a counter
+// reset, after which the counter started again from zero. With a Window they follow the semantics
+// of the PromQL rate and increase functions, including their extrapolation if enabled.
+// Derivative treats the values as gauges.
+//
+// Every function returns a new *data.Frame with the same Fields as its input, where the numeric value
+// Fields are replaced by *float64 Fields with the same Name, Labels and Config, and leaves its input
+// unmodified.
+package counter
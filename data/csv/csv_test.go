@@ -0,0 +1,125 @@
+package csv_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/csv"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestRead(t *testing.T) {
+	t1 := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+
+	tests := []struct {
+		name     string
+		input    string
+		opts     csv.ReadOptions
+		expected *data.Frame
+	}{
+		{
+			name: "type inference",
+			input: "time,host,count,value,up\n" +
+				"2024-01-02T03:04:05Z,a,1,1.5,true\n" +
+				"2024-01-02T03:05:05Z,\"b, c\",2,2,FALSE\n",
+			expected: data.NewFrame("",
+				data.NewField("time", nil, []time.Time{t1, t2}),
+				data.NewField("host", nil, []string{"a", "b, c"}),
+				data.NewField("count", nil, []int64{1, 2}),
+				data.NewField("value", nil, []float64{1.5, 2}),
+				data.NewField("up", nil, []bool{true, false}),
+			),
+		},
+		{
+			name:  "mixed case strings that are not bools",
+			input: "flag\ntRuE\nf\n",
+			expected: data.NewFrame("",
+				data.NewField("flag", nil, []string{"tRuE", "f"}),
+			),
+		},
+		{
+			name:  "empty cells make nullable fields",
+			input: "host,value,empty\na,,\n,2.5,\n",
+			expected: data.NewFrame("",
+				data.NewField("host", nil, []*string{ptr("a"), nil}),
+				data.NewField("value", nil, []*float64{nil, ptr(2.5)}),
+				data.NewField("empty", nil, []*string{nil, nil}),
+			),
+		},
+		{
+			name:  "epoch time fields, delimiter and no header",
+			input: "1704164645000;1\n1704164705000;2\n",
+			opts: csv.ReadOptions{
+				Delimiter:  ';',
+				NoHeader:   true,
+				TimeFields: []string{"Field 1"},
+				EpochUnit:  time.Millisecond,
+			},
+			expected: data.NewFrame("",
+				data.NewField("Field 1", nil, []time.Time{t1, t2}),
+				data.NewField("Field 2", nil, []int64{1, 2}),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			frame, err := csv.Read(strings.NewReader(tt.input), tt.opts)
+			require.NoError(t, err)
+			if diff := cmp.Diff(tt.expected, frame, data.FrameTestCompareOptions()...); diff != "" {
+				t.Errorf("Result mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	_, err := csv.Read(strings.NewReader("a,b\n1\n"), csv.ReadOptions{})
+	require.Error(t, err)
+
+	_, err = csv.Read(strings.NewReader("a\n1\n"), csv.ReadOptions{EpochUnit: time.Hour})
+	require.Error(t, err)
+}
+
+func TestWrite(t *testing.T) {
+	frame := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{time.Unix(1, 0), time.Unix(2, 0)}),
+		data.NewField("host", nil, []*string{ptr("a,b"), nil}),
+		data.NewField("value", nil, []float64{1.5, 2}),
+	)
+
+	var buf bytes.Buffer
+	require.NoError(t, csv.Write(&buf, frame, csv.WriteOptions{TimeFormat: csv.TimeFormatEpochMillis}))
+	require.Equal(t, "time,host,value\n1000,\"a,b\",1.5\n2000,,2\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, csv.Write(&buf, frame, csv.WriteOptions{NoHeader: true, Delimiter: '\t', Location: time.UTC}))
+	require.Equal(t, "1970-01-01T00:00:01Z\ta,b\t1.5\n1970-01-01T00:00:02Z\t\t2\n", buf.String())
+}
+
+func TestWriteAndRead(t *testing.T) {
+	frame := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{time.Unix(1, 0).UTC(), time.Unix(2, 0).UTC()}),
+		data.NewField("count", nil, []*int64{ptr(int64(1)), nil}),
+		data.NewField("ok", nil, []bool{true, false}),
+	)
+
+	var buf bytes.Buffer
+	require.NoError(t, csv.Write(&buf, frame, csv.WriteOptions{}))
+
+	out, err := csv.Read(&buf, csv.ReadOptions{})
+	require.NoError(t, err)
+	if diff := cmp.Diff(frame, out, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
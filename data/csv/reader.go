@@ -0,0 +1,233 @@
+// Package csv reads CSV data into data.Frames and writes data.Frames as CSV.
+package csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/converters"
+)
+
+// ReadOptions controls how CSV data is parsed by Read.
+type ReadOptions struct {
+	// Delimiter is the field delimiter. It is ',' if zero.
+	Delimiter rune
+
+	// Comment, if not zero, is the comment character. Lines beginning with the
+	// Comment character without preceding whitespace are ignored.
+	Comment rune
+
+	// NoHeader indicates the first record holds data rather than field names.
+	// Fields are then named "Field 1", "Field 2", and so on.
+	NoHeader bool
+
+	// LazyQuotes allows a quote to appear in an unquoted field and a
+	// non-doubled quote to appear in a quoted field (see encoding/csv).
+	LazyQuotes bool
+
+	// TrimLeadingSpace ignores leading white space in a field.
+	TrimLeadingSpace bool
+
+	// TimeFields are the names of the fields holding numeric epoch timestamps.
+	// Fields holding RFC3339 timestamps are detected without being listed.
+	TimeFields []string
+
+	// EpochUnit is the unit of the epoch timestamps in TimeFields,
+	// either time.Second or time.Millisecond. It is time.Second if zero.
+	EpochUnit time.Duration
+}
+
+// cellKind is the kind of values inferred for a column.
+type cellKind int
+
+const (
+	kindString cellKind = iota
+	kindBool
+	kindInt64
+	kindFloat64
+	kindTime
+	kindEpoch
+)
+
+// Read reads all records of r and returns them as a Frame.
+//
+// The type of each Field is inferred from its values: int64 if all values are integers,
+// float64 if all values are numbers, bool if all values are booleans accepted by strconv.ParseBool
+// (such as true, FALSE or t), time.Time if all
+// values are RFC3339 timestamps (or the field is listed in opts.TimeFields and all values are
+// numbers), and string otherwise.
+// Empty cells are null values, in which case the Field is of the nullable variant of the inferred type.
+func Read(r io.Reader, opts ReadOptions) (*data.Frame, error) {
+	switch opts.EpochUnit {
+	case 0, time.Second, time.Millisecond:
+	default:
+		return nil, fmt.Errorf("unsupported epoch unit %s, must be %s or %s", opts.EpochUnit, time.Second, time.Millisecond)
+	}
+
+	cr := csv.NewReader(r)
+	if opts.Delimiter != 0 {
+		cr.Comma = opts.Delimiter
+	}
+	cr.Comment = opts.Comment
+	cr.LazyQuotes = opts.LazyQuotes
+	cr.TrimLeadingSpace = opts.TrimLeadingSpace
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return data.NewFrame(""), nil
+	}
+
+	var names []string
+	if opts.NoHeader {
+		names = make([]string, len(records[0]))
+		for i := range names {
+			names[i] = fmt.Sprintf("Field %d", i+1)
+		}
+	} else {
+		names, records = records[0], records[1:]
+	}
+
+	timeFields := make(map[string]struct{}, len(opts.TimeFields))
+	for _, name := range opts.TimeFields {
+		timeFields[name] = struct{}{}
+	}
+
+	frame := data.NewFrame("")
+	for colIdx, name := range names {
+		kind, nullable := inferColumn(records, colIdx)
+		if _, ok := timeFields[name]; ok && (kind == kindInt64 || kind == kindFloat64) {
+			kind = kindEpoch
+		}
+
+		ft := fieldType(kind)
+		if nullable {
+			ft = ft.NullableType()
+		}
+		field := data.NewFieldFromFieldType(ft, len(records))
+		field.Name = name
+
+		for rowIdx, record := range records {
+			cell := record[colIdx]
+			if cell == "" {
+				continue
+			}
+			v, err := parseCell(cell, kind, opts.EpochUnit)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse value %q of field %q in row %d: %w", cell, name, rowIdx, err)
+			}
+			field.SetConcrete(rowIdx, v)
+		}
+		frame.Fields = append(frame.Fields, field)
+	}
+	return frame, nil
+}
+
+// inferColumn returns the narrowest kind that can hold all non-empty cells of the column
+// at colIdx, and whether the column has empty cells.
+func inferColumn(records [][]string, colIdx int) (cellKind, bool) {
+	isInt, isFloat, isBool, isTime := true, true, true, true
+	hasValues, nullable := false, false
+	for _, record := range records {
+		cell := record[colIdx]
+		if cell == "" {
+			nullable = true
+			continue
+		}
+		hasValues = true
+		if isInt {
+			_, err := strconv.ParseInt(cell, 10, 64)
+			isInt = err == nil
+		}
+		if isFloat {
+			_, err := strconv.ParseFloat(cell, 64)
+			isFloat = err == nil
+		}
+		if isBool {
+			_, err := strconv.ParseBool(cell)
+			isBool = err == nil
+		}
+		if isTime {
+			_, err := time.Parse(time.RFC3339, cell)
+			isTime = err == nil
+		}
+	}
+
+	switch {
+	case !hasValues:
+		return kindString, nullable
+	case isInt:
+		return kindInt64, nullable
+	case isFloat:
+		return kindFloat64, nullable
+	case isBool:
+		return kindBool, nullable
+	case isTime:
+		return kindTime, nullable
+	default:
+		return kindString, nullable
+	}
+}
+
+func fieldType(kind cellKind) data.FieldType {
+	switch kind {
+	case kindBool:
+		return data.FieldTypeBool
+	case kindInt64:
+		return data.FieldTypeInt64
+	case kindFloat64:
+		return data.FieldTypeFloat64
+	case kindTime, kindEpoch:
+		return data.FieldTypeTime
+	default:
+		return data.FieldTypeString
+	}
+}
+
+// parseCell converts a non-empty cell to the concrete (non-pointer) value of the kind.
+func parseCell(cell string, kind cellKind, epochUnit time.Duration) (interface{}, error) {
+	switch kind {
+	case kindBool:
+		v, err := converters.StringToNullableBool.Converter(cell)
+		if err != nil {
+			return nil, err
+		}
+		return *v.(*bool), nil
+	case kindInt64:
+		v, err := converters.StringToNullableInt64.Converter(cell)
+		if err != nil {
+			return nil, err
+		}
+		return *v.(*int64), nil
+	case kindFloat64:
+		v, err := converters.StringToNullableFloat64.Converter(cell)
+		if err != nil {
+			return nil, err
+		}
+		return *v.(*float64), nil
+	case kindTime:
+		t, err := converters.RFC3339StringToNullableTime(cell)
+		if err != nil {
+			return nil, err
+		}
+		return *t, nil
+	case kindEpoch:
+		v, err := converters.StringToNullableFloat64.Converter(cell)
+		if err != nil {
+			return nil, err
+		}
+		toTime := converters.Float64EpochSecondsToTime
+		if epochUnit == time.Millisecond {
+			toTime = converters.Float64EpochMillisToTime
+		}
+		return toTime.Converter(*v.(*float64))
+	default:
+		return cell, nil
+	}
+}
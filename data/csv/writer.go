@@ -0,0 +1,117 @@
+package csv
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// Special values of WriteOptions.TimeFormat that write times as numeric epoch timestamps
+// instead of formatting them with a layout.
+const (
+	TimeFormatEpochSeconds = "epoch_s"
+	TimeFormatEpochMillis  = "epoch_ms"
+)
+
+// WriteOptions controls how a Frame is written by Write.
+type WriteOptions struct {
+	// Delimiter is the field delimiter. It is ',' if zero.
+	Delimiter rune
+
+	// NoHeader omits the header record holding the field names.
+	NoHeader bool
+
+	// UseCRLF uses \r\n as the line terminator.
+	UseCRLF bool
+
+	// TimeFormat is the layout (see time.Layout) used to format time values, or one of
+	// TimeFormatEpochSeconds and TimeFormatEpochMillis. It is time.RFC3339Nano if empty.
+	TimeFormat string
+
+	// Location, if not nil, is the location times are converted to before being formatted.
+	Location *time.Location
+}
+
+// Write writes frame to w as CSV: a header record with the field names (unless opts.NoHeader is set)
+// followed by one record per row. Null values are written as empty cells.
+// All fields of the frame must be of the same length or an error is returned.
+func Write(w io.Writer, frame *data.Frame, opts WriteOptions) error {
+	rowLen, err := frame.RowLen()
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if opts.Delimiter != 0 {
+		cw.Comma = opts.Delimiter
+	}
+	cw.UseCRLF = opts.UseCRLF
+
+	record := make([]string, len(frame.Fields))
+	if !opts.NoHeader {
+		for i, field := range frame.Fields {
+			record[i] = field.Name
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	for rowIdx := 0; rowIdx < rowLen; rowIdx++ {
+		for i, field := range frame.Fields {
+			v, ok := field.ConcreteAt(rowIdx)
+			if !ok {
+				record[i] = ""
+				continue
+			}
+			record[i] = formatValue(v, opts)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatValue(v interface{}, opts WriteOptions) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case bool:
+		return strconv.FormatBool(v)
+	case json.RawMessage:
+		return string(v)
+	case time.Time:
+		return formatTime(v, opts)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatTime(t time.Time, opts WriteOptions) string {
+	switch opts.TimeFormat {
+	case TimeFormatEpochSeconds:
+		return strconv.FormatInt(t.Unix(), 10)
+	case TimeFormatEpochMillis:
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	}
+	if opts.Location != nil {
+		t = t.In(opts.Location)
+	}
+	layout := opts.TimeFormat
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+	return t.Format(layout)
+}
@@ -0,0 +1,187 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number, the item type of FieldTypeDecimal Fields.
+// Its value is Unscaled() * 10^-Scale(), for example 12.345 has an unscaled value of 12345 and a scale of 3.
+// The zero value is 0.
+//
+// Decimal values are immutable, so they can be copied and compared with Equal or Cmp
+// (two Decimals that differ in scale but not in value, such as 1.5 and 1.50, are equal).
+type Decimal struct {
+	unscaled *big.Int // nil is 0
+	scale    int32
+}
+
+// MaxDecimalPrecision is the maximum number of digits of a Decimal, and the maximum of its scale,
+// which is what the arrow decimal256 type can hold.
+const MaxDecimalPrecision = 76
+
+var bigTen = big.NewInt(10)
+
+// NewDecimal returns the Decimal with the value unscaled * 10^-scale. A negative scale is
+// normalized to a scale of 0. The unscaled value is copied.
+// The scale should be between -MaxDecimalPrecision and MaxDecimalPrecision.
+func NewDecimal(unscaled *big.Int, scale int32) Decimal {
+	u := new(big.Int)
+	if unscaled != nil {
+		u.Set(unscaled)
+	}
+	if scale < 0 {
+		u.Mul(u, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{unscaled: u, scale: scale}
+}
+
+// NewDecimalFromInt64 returns the Decimal with the value unscaled * 10^-scale.
+func NewDecimalFromInt64(unscaled int64, scale int32) Decimal {
+	return NewDecimal(big.NewInt(unscaled), scale)
+}
+
+// NewDecimalFromFloat64 returns the Decimal with the shortest decimal representation of f.
+// An error is returned if f is NaN or infinite, or if that representation needs more than MaxDecimalPrecision digits.
+func NewDecimalFromFloat64(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, fmt.Errorf("can not convert %v to a decimal", f)
+	}
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// ParseDecimal parses a decimal number such as "-12.345" or "1.2e-3" into a Decimal without losing precision.
+// An error is returned if the Decimal would have a scale or a precision larger than MaxDecimalPrecision.
+func ParseDecimal(s string) (Decimal, error) {
+	str := s
+	exp := int64(0)
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q: %w", s, err)
+		}
+		str = str[:i]
+	}
+
+	digits := str
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		digits = str[:i] + str[i+1:]
+		exp -= int64(len(str) - i - 1)
+	}
+	unsigned := strings.TrimLeft(digits, "+-")
+	if unsigned == "" || len(digits)-len(unsigned) > 1 || strings.ContainsAny(unsigned, "+-") {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	// bound the exponent before NewDecimal multiplies by a power of 10
+	if exp < -MaxDecimalPrecision || exp > MaxDecimalPrecision {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: exponent out of range, the maximum precision is %d", s, MaxDecimalPrecision)
+	}
+	d := NewDecimal(unscaled, int32(-exp))
+	if d.precision() > MaxDecimalPrecision {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: more than %d digits", s, MaxDecimalPrecision)
+	}
+	return d, nil
+}
+
+// Unscaled returns a copy of the unscaled value of the Decimal.
+func (d Decimal) Unscaled() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(d.unscaled)
+}
+
+// Scale returns the number of digits after the decimal point of the Decimal.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// String returns the Decimal in plain notation, with Scale() digits after the decimal point.
+func (d Decimal) String() string {
+	u := d.Unscaled()
+	if d.scale == 0 {
+		return u.String()
+	}
+	sign := ""
+	if u.Sign() < 0 {
+		sign = "-"
+		u.Neg(u)
+	}
+	digits := u.String()
+	if pad := int(d.scale) - len(digits) + 1; pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(d.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// Float64 returns the float64 value nearest to the Decimal.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Cmp compares d and o and returns -1, 0 or +1 if d is less than, equal to or greater than o.
+func (d Decimal) Cmp(o Decimal) int {
+	scale := d.scale
+	if o.scale > scale {
+		scale = o.scale
+	}
+	return d.rescale(scale).Cmp(o.rescale(scale))
+}
+
+// Equal returns true if d and o have the same value.
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// MarshalJSON marshals the Decimal as a JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON unmarshals a JSON number or string into the Decimal.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// rescale returns the unscaled value of the Decimal for the given scale, which must not be less than d's scale.
+func (d Decimal) rescale(scale int32) *big.Int {
+	u := d.Unscaled()
+	if scale > d.scale {
+		u.Mul(u, pow10(scale-d.scale))
+	}
+	return u
+}
+
+// precision returns the number of digits of the unscaled value of the Decimal.
+func (d Decimal) precision() int32 {
+	u := d.Unscaled()
+	if u.Sign() == 0 {
+		return 1
+	}
+	return int32(len(u.Abs(u).String())) // #nosec G115
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
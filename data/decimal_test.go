@@ -0,0 +1,173 @@
+package data_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestParseDecimal(t *testing.T) {
+	for _, tt := range []struct {
+		in       string
+		unscaled string
+		scale    int32
+		str      string
+	}{
+		{in: "0", unscaled: "0", scale: 0, str: "0"},
+		{in: "12.345", unscaled: "12345", scale: 3, str: "12.345"},
+		{in: "-0.001", unscaled: "-1", scale: 3, str: "-0.001"},
+		{in: "+7.50", unscaled: "750", scale: 2, str: "7.50"},
+		{in: "1.2e-3", unscaled: "12", scale: 4, str: "0.0012"},
+		{in: "1.5E2", unscaled: "150", scale: 0, str: "150"},
+		{in: "1e-76", unscaled: "1", scale: 76, str: "0." + strings.Repeat("0", 75) + "1"},
+		{in: "1e75", unscaled: "1" + strings.Repeat("0", 75), scale: 0, str: "1" + strings.Repeat("0", 75)},
+		{in: "12345678901234567890.0123456789", unscaled: "123456789012345678900123456789", scale: 10, str: "12345678901234567890.0123456789"},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := data.ParseDecimal(tt.in)
+			require.NoError(t, err)
+			require.Equal(t, tt.unscaled, d.Unscaled().String())
+			require.Equal(t, tt.scale, d.Scale())
+			require.Equal(t, tt.str, d.String())
+		})
+	}
+
+	for _, in := range []string{"", "-", "1.2.3", "--1", "1-2", "abc", "1e", "1e99999999999",
+		// exponents, scales and precisions that decimal256 can not hold
+		"1e2147483647", "1e-2147483647", "1e-2000000000", "1e-77", "1e76", "0.00000000000000000000000000000000000000000000000000000000000000000000000000001",
+		"12345678901234567890123456789012345678901234567890123456789012345678901234567",
+	} {
+		_, err := data.ParseDecimal(in)
+		require.Error(t, err, in)
+		var d data.Decimal
+		require.Error(t, json.Unmarshal([]byte(`"`+in+`"`), &d), in)
+	}
+}
+
+func TestDecimalCmp(t *testing.T) {
+	a := data.NewDecimalFromInt64(150, 2)
+	b, err := data.ParseDecimal("1.5")
+	require.NoError(t, err)
+	require.True(t, a.Equal(b))
+	require.True(t, a.Equal(data.NewDecimal(big.NewInt(15), 1)))
+	require.Equal(t, -1, data.NewDecimalFromInt64(-1, 0).Cmp(a))
+	require.Equal(t, 1, a.Cmp(data.Decimal{}))
+	require.Equal(t, "1500", data.NewDecimalFromInt64(15, -2).String())
+	require.Equal(t, 1.5, a.Float64())
+
+	d, err := data.NewDecimalFromFloat64(0.1)
+	require.NoError(t, err)
+	require.Equal(t, "0.1", d.String())
+
+	encoded, err := json.Marshal([]data.Decimal{a})
+	require.NoError(t, err)
+	require.Equal(t, `[1.50]`, string(encoded))
+	var decoded []data.Decimal
+	require.NoError(t, json.Unmarshal([]byte(`[1.50, "2.25"]`), &decoded))
+	require.Equal(t, "2.25", decoded[1].String())
+}
+
+func TestDecimalAndDurationFieldTypes(t *testing.T) {
+	require.Equal(t, data.FieldTypeDecimal, data.FieldTypeFor(data.Decimal{}))
+	require.Equal(t, data.FieldTypeNullableDecimal, data.FieldTypeFor(&data.Decimal{}))
+	require.Equal(t, data.FieldTypeDuration, data.FieldTypeFor(time.Second))
+	require.Equal(t, data.FieldTypeNullableDuration, data.FieldTypeFor(pointer(time.Second)))
+
+	require.True(t, data.FieldTypeDecimal.Numeric())
+	require.False(t, data.FieldTypeDuration.Numeric())
+	require.True(t, data.FieldTypeNullableDuration.Duration())
+	require.Equal(t, data.FieldTypeDuration, data.FieldTypeNullableDuration.NonNullableType())
+
+	for _, ft := range []data.FieldType{data.FieldTypeDecimal, data.FieldTypeNullableDecimal, data.FieldTypeDuration, data.FieldTypeNullableDuration} {
+		parsed, ok := data.FieldTypeFromItemTypeString(ft.ItemTypeString())
+		require.True(t, ok)
+		require.Equal(t, ft, parsed)
+		require.Equal(t, ft, data.NewFieldFromFieldType(ft, 1).Type())
+	}
+
+	field := data.NewField("value", nil, []*data.Decimal{pointer(data.NewDecimalFromInt64(125, 2)), nil})
+	v, err := field.FloatAt(0)
+	require.NoError(t, err)
+	require.Equal(t, 1.25, v)
+	p, err := field.NullableFloatAt(1)
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	v, err = data.NewField("duration", nil, []time.Duration{1500 * time.Microsecond}).FloatAt(0)
+	require.NoError(t, err)
+	require.Equal(t, 1.5, v)
+}
+
+func decimalDurationFrame(t *testing.T) *data.Frame {
+	t.Helper()
+	large, err := data.ParseDecimal("12345678901234567890.0123456789")
+	require.NoError(t, err)
+	huge, err := data.ParseDecimal("-1234567890123456789012345678901234567890.5")
+	require.NoError(t, err)
+
+	return data.NewFrame("decimals",
+		data.NewField("decimal", nil, []data.Decimal{large, data.NewDecimalFromInt64(-5, 1), {}}),
+		data.NewField("nullable_decimal", nil, []*data.Decimal{nil, pointer(data.NewDecimalFromInt64(1, 0)), &huge}),
+		data.NewField("duration", nil, []time.Duration{time.Second, -1500 * time.Microsecond, time.Nanosecond}),
+		data.NewField("nullable_duration", nil, []*time.Duration{nil, pointer(90 * time.Minute), pointer(2*time.Millisecond + 7)}),
+	)
+}
+
+func TestDecimalAndDurationArrowRoundTrip(t *testing.T) {
+	frame := decimalDurationFrame(t)
+	b, err := frame.MarshalArrow()
+	require.NoError(t, err)
+
+	for _, unmarshal := range []func([]byte) (*data.Frame, error){data.UnmarshalArrowFrame, data.UnmarshalArrowFrameZeroCopy} {
+		decoded, err := unmarshal(b)
+		require.NoError(t, err)
+		if diff := cmp.Diff(frame, decoded, data.FrameTestCompareOptions()...); diff != "" {
+			t.Errorf("Result mismatch (-want +got):\n%s", diff)
+		}
+		// decimals read from arrow have the scale of the arrow column
+		require.Equal(t, "-0.5000000000", decoded.Fields[0].At(1).(data.Decimal).String())
+	}
+
+	table, err := data.FrameToArrowTable(frame)
+	require.NoError(t, err)
+	defer table.Release()
+	require.Equal(t, "decimal(30, 10)", table.Schema().Field(0).Type.String())
+	require.Equal(t, "decimal256(41, 1)", table.Schema().Field(1).Type.String())
+	require.Equal(t, "duration[ns]", table.Schema().Field(2).Type.String())
+}
+
+func TestDecimalAndDurationJSON(t *testing.T) {
+	frame := decimalDurationFrame(t)
+	b, err := json.Marshal(frame)
+	require.NoError(t, err)
+
+	// decimals are exact JSON numbers and durations are milliseconds with nanosecond offsets like times.
+	require.Contains(t, string(b), `[12345678901234567890.0123456789,-0.5,0]`)
+	require.Contains(t, string(b), `[1000,-1,0]`)
+	require.Contains(t, string(b), `"nanos":[null,null,[0,-500000,1],[0,0,7]]`)
+	require.Contains(t, string(b), `"typeInfo":{"frame":"decimal","nullable":true}`)
+
+	decoded := &data.Frame{}
+	require.NoError(t, json.Unmarshal(b, decoded))
+	if diff := cmp.Diff(frame, decoded, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+	require.Equal(t, "12345678901234567890.0123456789", decoded.Fields[0].At(0).(data.Decimal).String())
+
+	arrowBytes, err := frame.MarshalArrow()
+	require.NoError(t, err)
+	fromArrow, err := data.ArrowBufferToJSON(arrowBytes, data.IncludeAll)
+	require.NoError(t, err)
+	decoded = &data.Frame{}
+	require.NoError(t, json.Unmarshal(fromArrow, decoded))
+	if diff := cmp.Diff(frame, decoded, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
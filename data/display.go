@@ -0,0 +1,169 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// DisplayTimeFormat is the layout of time values in DisplayValue.Text.
+const DisplayTimeFormat = "2006-01-02 15:04:05"
+
+// DisplayValue is a value of a Field as it is displayed, after the Field's value mappings and
+// thresholds are applied.
+type DisplayValue struct {
+	// Text is the text of the matching value mapping, or else the formatted value.
+	// A null value without a mapping is the Field's NoValue.
+	Text string
+
+	// Numeric is the value as a float64, NaN if the value is null or not numeric.
+	Numeric float64
+
+	// Color is the color of the matching value mapping, or else the fixed color of the Field,
+	// or else the color of the active threshold step. It is empty if there is none.
+	Color string
+
+	// Threshold is the active threshold step of a numeric value, nil if the value is not numeric
+	// or the Field has no thresholds.
+	Threshold *Threshold
+
+	// Mapping is the result of the value mapping that matched the value, nil if none matched.
+	Mapping *ValueMappingResult
+}
+
+// DisplayProcessor applies the value mappings and thresholds of a Field's config to values of the
+// Field, with the same semantics as the frontend, so server-side code such as reports and
+// notifications can show values like panels do. Units are not applied.
+type DisplayProcessor struct {
+	field    *Field
+	config   *FieldConfig
+	regexps  []*regexp.Regexp // the compiled patterns of the value mappings
+	min, max float64
+}
+
+// NewDisplayProcessor returns a DisplayProcessor for field. The range of percentage thresholds is
+// the Min and Max of the Field's config, and defaults to the minimum and maximum of the Field's values.
+func NewDisplayProcessor(field *Field) *DisplayProcessor {
+	p := &DisplayProcessor{field: field, config: field.Config, min: math.NaN(), max: math.NaN()}
+	if p.config == nil {
+		p.config = &FieldConfig{}
+	}
+	p.regexps = p.config.Mappings.regexps()
+	if p.config.Min != nil && p.config.Max != nil {
+		p.min, p.max = float64(*p.config.Min), float64(*p.config.Max)
+		return p
+	}
+
+	if field.Type().Numeric() {
+		for i := 0; i < field.Len(); i++ {
+			v, err := field.NullableFloatAt(i)
+			if err != nil || v == nil || math.IsNaN(*v) || math.IsInf(*v, 0) {
+				continue
+			}
+			if math.IsNaN(p.min) || *v < p.min {
+				p.min = *v
+			}
+			if math.IsNaN(p.max) || *v > p.max {
+				p.max = *v
+			}
+		}
+	}
+	if p.config.Min != nil {
+		p.min = float64(*p.config.Min)
+	}
+	if p.config.Max != nil {
+		p.max = float64(*p.config.Max)
+	}
+	return p
+}
+
+// DisplayAt returns the DisplayValue of the value at idx.
+func (p *DisplayProcessor) DisplayAt(idx int) DisplayValue {
+	v, ok := p.field.ConcreteAt(idx)
+	if !ok {
+		v = nil
+	}
+	return p.Display(v)
+}
+
+// Display returns the DisplayValue of value, which is a concrete value as returned by
+// Field.ConcreteAt, or nil for null.
+func (p *DisplayProcessor) Display(value interface{}) DisplayValue {
+	dv := DisplayValue{Numeric: math.NaN()}
+	if f, ok := concreteFloat(value); ok {
+		dv.Numeric = f
+		if step, ok := p.config.Thresholds.ActiveStep(f, p.min, p.max); ok {
+			dv.Threshold = &step
+		}
+	}
+
+	if result, ok := p.config.Mappings.mapValue(value, p.regexps); ok {
+		dv.Mapping = &result
+		dv.Text = result.Text
+		dv.Color = result.Color
+	}
+	if dv.Mapping == nil || dv.Text == "" {
+		dv.Text = p.formatValue(value)
+	}
+	if dv.Color == "" {
+		dv.Color = p.fixedColor()
+	}
+	if dv.Color == "" && dv.Threshold != nil {
+		dv.Color = dv.Threshold.Color
+	}
+	return dv
+}
+
+// DisplayValues returns the DisplayValues of all values of field.
+func DisplayValues(field *Field) []DisplayValue {
+	p := NewDisplayProcessor(field)
+	values := make([]DisplayValue, field.Len())
+	for i := range values {
+		values[i] = p.DisplayAt(i)
+	}
+	return values
+}
+
+func (p *DisplayProcessor) formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return p.config.NoValue
+	case string:
+		if v == "" && p.config.NoValue != "" {
+			return p.config.NoValue
+		}
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(DisplayTimeFormat)
+	case json.RawMessage:
+		return string(v)
+	case Decimal:
+		if p.config.Decimals == nil {
+			return v.String()
+		}
+	}
+
+	f, ok := concreteFloat(value)
+	if !ok {
+		return fmt.Sprint(value)
+	}
+	decimals := -1
+	if p.config.Decimals != nil {
+		decimals = int(*p.config.Decimals)
+	}
+	return strconv.FormatFloat(f, 'f', decimals, 64)
+}
+
+// fixedColor returns the color of the Field's config in the "fixed" color mode.
+func (p *DisplayProcessor) fixedColor() string {
+	if p.config.Color["mode"] != "fixed" {
+		return ""
+	}
+	color, _ := p.config.Color["fixedColor"].(string)
+	return color
+}
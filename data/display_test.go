@@ -0,0 +1,159 @@
+package data_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestValueMappingsMap(t *testing.T) {
+	var mappings data.ValueMappings
+	err := json.Unmarshal([]byte(`[
+		{"type": "value", "options": {"1": {"text": "ON", "color": "green"}, "off": {"text": "OFF"}}},
+		{"type": "range", "options": {"from": 10, "to": 20, "result": {"text": "10-20", "color": "yellow"}}},
+		{"type": "range", "options": {"from": 100, "result": {"text": "high", "color": "red"}}},
+		{"type": "regex", "options": {"pattern": "host-(\\d+)", "result": {"text": "Host $1"}}},
+		{"type": "regex", "options": {"pattern": "/ERROR/i", "result": {"color": "red"}}},
+		{"type": "special", "options": {"match": "null+nan", "result": {"text": "n/a"}}},
+		{"type": "special", "options": {"match": "true", "result": {"text": "yes"}}},
+		{"type": "special", "options": {"match": "empty", "result": {"text": "(empty)"}}}
+	]`), &mappings)
+	require.NoError(t, err)
+	require.Equal(t, data.RegexValueMapper{Pattern: `host-(\d+)`, Result: data.ValueMappingResult{Text: "Host $1"}}, mappings[3])
+
+	out, err := json.Marshal(mappings)
+	require.NoError(t, err)
+	require.Contains(t, string(out), `{"type":"regex","options":{"pattern":"host-(\\d+)","result":{"text":"Host $1"}}}`)
+
+	tests := []struct {
+		value    interface{}
+		expected data.ValueMappingResult
+		ok       bool
+	}{
+		{value: int64(1), expected: data.ValueMappingResult{Text: "ON", Color: "green"}, ok: true},
+		{value: 1.0, expected: data.ValueMappingResult{Text: "ON", Color: "green"}, ok: true},
+		{value: "off", expected: data.ValueMappingResult{Text: "OFF"}, ok: true},
+		{value: 10.0, expected: data.ValueMappingResult{Text: "10-20", Color: "yellow"}, ok: true},
+		{value: uint8(20), expected: data.ValueMappingResult{Text: "10-20", Color: "yellow"}, ok: true},
+		{value: 20.5},
+		{value: 1e6, expected: data.ValueMappingResult{Text: "high", Color: "red"}, ok: true},
+		{value: "host-12", expected: data.ValueMappingResult{Text: "Host 12"}, ok: true},
+		{value: "a host-12"},
+		{value: "an error occurred", expected: data.ValueMappingResult{Color: "red"}, ok: true},
+		{value: nil, expected: data.ValueMappingResult{Text: "n/a"}, ok: true},
+		{value: math.NaN(), expected: data.ValueMappingResult{Text: "n/a"}, ok: true},
+		{value: true, expected: data.ValueMappingResult{Text: "yes"}, ok: true},
+		{value: "true", expected: data.ValueMappingResult{Text: "yes"}, ok: true},
+		{value: false},
+		{value: "", expected: data.ValueMappingResult{Text: "(empty)"}, ok: true},
+	}
+	for _, tt := range tests {
+		result, ok := mappings.Map(tt.value)
+		require.Equal(t, tt.ok, ok, "value %#v", tt.value)
+		require.Equal(t, tt.expected, result, "value %#v", tt.value)
+	}
+}
+
+func TestThresholdsActiveStep(t *testing.T) {
+	thresholds := &data.ThresholdsConfig{
+		Mode: data.ThresholdsModeAbsolute,
+		Steps: []data.Threshold{
+			data.NewThreshold(math.Inf(-1), "green", "ok"),
+			data.NewThreshold(50, "orange", "warn"),
+			data.NewThreshold(80, "red", "critical"),
+		},
+	}
+
+	for value, expected := range map[float64]string{-10: "ok", 49.9: "ok", 50: "warn", 80: "critical", math.Inf(1): "critical", math.NaN(): "ok"} {
+		step, ok := thresholds.ActiveStep(value, 0, 0)
+		require.True(t, ok)
+		require.Equal(t, expected, step.State, "value %v", value)
+	}
+
+	thresholds.Mode = data.ThresholdsModePercentage
+	step, _ := thresholds.ActiveStep(150, 100, 200)
+	require.Equal(t, "warn", step.State)
+	step, _ = thresholds.ActiveStep(190, 100, 200)
+	require.Equal(t, "critical", step.State)
+
+	_, ok := (*data.ThresholdsConfig)(nil).ActiveStep(1, 0, 0)
+	require.False(t, ok)
+}
+
+func TestDisplayProcessor(t *testing.T) {
+	field := data.NewField("value", nil, []*float64{float64Ptr(0), float64Ptr(1.2345), float64Ptr(9), nil, float64Ptr(10)})
+	field.SetConfig((&data.FieldConfig{
+		NoValue: "-",
+		Mappings: data.ValueMappings{
+			data.ValueMapper{"0": {Text: "zero"}},
+			data.RangeValueMapper{From: pointer(data.ConfFloat64(9)), To: pointer(data.ConfFloat64(9)), Result: data.ValueMappingResult{Color: "purple"}},
+		},
+		Thresholds: &data.ThresholdsConfig{
+			Mode: data.ThresholdsModePercentage,
+			Steps: []data.Threshold{
+				data.NewThreshold(math.Inf(-1), "green", ""),
+				data.NewThreshold(90, "red", "critical"),
+			},
+		},
+	}).SetDecimals(2))
+
+	values := data.DisplayValues(field)
+	require.Len(t, values, 5)
+
+	require.Equal(t, "zero", values[0].Text)
+	require.Equal(t, "green", values[0].Color)
+	require.Equal(t, &data.ValueMappingResult{Text: "zero"}, values[0].Mapping)
+
+	require.Equal(t, "1.23", values[1].Text)
+	require.Equal(t, 1.2345, values[1].Numeric)
+	require.Equal(t, "green", values[1].Color)
+	require.Nil(t, values[1].Mapping)
+
+	// 9 is 90% of the range of the values, the mapping color wins over the threshold color
+	require.Equal(t, "9.00", values[2].Text)
+	require.Equal(t, "purple", values[2].Color)
+	require.Equal(t, "critical", values[2].Threshold.State)
+
+	require.Equal(t, "-", values[3].Text)
+	require.True(t, math.IsNaN(values[3].Numeric))
+	require.Nil(t, values[3].Threshold)
+	require.Equal(t, "", values[3].Color)
+
+	require.Equal(t, "red", values[4].Color)
+
+	// the range of percentage thresholds comes from the config when it is set
+	field.Config.Max = pointer(data.ConfFloat64(100))
+	require.Equal(t, "green", data.NewDisplayProcessor(field).DisplayAt(4).Color)
+
+	// fixed colors win over thresholds
+	field.Config.Color = map[string]interface{}{"mode": "fixed", "fixedColor": "blue"}
+	require.Equal(t, "blue", data.NewDisplayProcessor(field).DisplayAt(4).Color)
+
+	ts := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+	timeField := data.NewField("time", nil, []time.Time{ts})
+	require.Equal(t, "2024-02-03 04:05:06", data.NewDisplayProcessor(timeField).DisplayAt(0).Text)
+
+	stringField := data.NewField("host", nil, []string{"a", ""})
+	require.Equal(t, []string{"a", ""}, []string{data.DisplayValues(stringField)[0].Text, data.DisplayValues(stringField)[1].Text})
+}
+
+func TestDisplayProcessorRegexMappings(t *testing.T) {
+	field := data.NewField("host", nil, []string{"host-1", "db-2", "other"}).SetConfig(&data.FieldConfig{
+		Mappings: data.ValueMappings{
+			data.RegexValueMapper{Pattern: "host-(\\d+)", Result: data.ValueMappingResult{Text: "${1}abc"}},
+			data.RegexValueMapper{Pattern: "(", Result: data.ValueMappingResult{Text: "invalid"}},
+			data.RegexValueMapper{Pattern: "/DB-(\\d)/i", Result: data.ValueMappingResult{Text: "database $1"}},
+		},
+	})
+
+	p := data.NewDisplayProcessor(field)
+	require.Equal(t, "1abc", p.DisplayAt(0).Text)
+	require.Equal(t, "database 2", p.DisplayAt(1).Text)
+	require.Equal(t, "other", p.DisplayAt(2).Text)
+	require.Nil(t, p.DisplayAt(2).Mapping)
+}
@@ -0,0 +1,7 @@
+// Package downsample reduces the number of points of wide and long time series frames to the
+// MaxDataPoints of a query, for data sources whose upstream can not downsample itself.
+//
+// The number of points of each series is limited by Options.MaxDataPoints and, if set,
+// the points are at least about Options.Interval apart. Frames with fewer points are
+// returned unchanged.
+package downsample
@@ -0,0 +1,454 @@
+package downsample
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// Method is the name of a downsampling algorithm.
+type Method string
+
+const (
+	// LTTB selects the points that best keep the visual shape of each series with the
+	// Largest-Triangle-Three-Buckets algorithm. It selects up to MaxDataPoints points.
+	LTTB Method = "lttb"
+
+	// M4 selects the first, last, smallest and largest point of each time bucket, which keeps
+	// line charts of the series identical to the chart of all points. It selects up to
+	// MaxDataPoints points from MaxDataPoints/4 buckets.
+	M4 Method = "m4"
+
+	// MinMax selects the smallest and largest point of each time bucket, which keeps the peaks of
+	// the series. It selects up to MaxDataPoints points from MaxDataPoints/2 buckets.
+	MinMax Method = "minmax"
+
+	// Average replaces the points of each time bucket with their mean, at the start time of the bucket.
+	// It returns up to MaxDataPoints points per series from MaxDataPoints buckets.
+	Average Method = "avg"
+)
+
+// Options configure downsampling. MaxDataPoints and Interval are usually the
+// MaxDataPoints and Interval of the backend.DataQuery the frames are the response to.
+type Options struct {
+	// Method is the downsampling algorithm. When empty, LTTB is used.
+	Method Method
+
+	// MaxDataPoints is the maximum number of points of each series. Frames are not downsampled
+	// if it is 0 or less.
+	MaxDataPoints int64
+
+	// Interval, if greater than 0, is the smallest width of a time bucket. The width of buckets is
+	// a multiple of Interval, or of a millisecond if Interval is smaller, and they are aligned to it.
+	// For LTTB, it limits the number of points to one per Interval.
+	Interval time.Duration
+}
+
+// pointsPerBucket returns the number of points the method selects from one time bucket.
+func (m Method) pointsPerBucket() (int64, error) {
+	switch m {
+	case LTTB, Average:
+		return 1, nil
+	case MinMax:
+		return 2, nil
+	case M4:
+		return 4, nil
+	default:
+		return 0, fmt.Errorf("unknown downsampling method %q", m)
+	}
+}
+
+// Frames downsamples each time series frame of frames with Frame. Frames that are not time
+// series are returned unchanged.
+func Frames(frames data.Frames, opts Options) (data.Frames, error) {
+	out := make(data.Frames, 0, len(frames))
+	for _, frame := range frames {
+		if frame.TimeSeriesSchema().Type == data.TimeSeriesTypeNot {
+			out = append(out, frame)
+			continue
+		}
+		downsampled, err := Frame(frame, opts)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, downsampled)
+	}
+	return out, nil
+}
+
+// Frame downsamples a wide or long time series frame, whose time Field must be sorted in
+// ascending order, so that each of its series has no more than opts.MaxDataPoints points.
+// The series of a long frame are the rows with the same values of the string and bool Fields, and
+// are downsampled with the same time buckets.
+//
+// LTTB, M4 and MinMax return a Frame with the selected rows of frame. Only numeric Fields are used
+// to select rows, and null and NaN values are never selected. When a wide frame has more than one
+// numeric Field, the rows selected for any of them are kept, so it may have more rows than
+// MaxDataPoints. Average returns a Frame of the same Fields where the numeric Fields are replaced
+// by *float64 Fields of the bucket means, and fails if the frame has other value Fields.
+//
+// If no series has more than opts.MaxDataPoints points, frame itself is returned.
+// Otherwise frame is left unmodified.
+func Frame(frame *data.Frame, opts Options) (*data.Frame, error) {
+	if opts.Method == "" {
+		opts.Method = LTTB
+	}
+	perBucket, err := opts.Method.pointsPerBucket()
+	if err != nil {
+		return nil, err
+	}
+
+	schema := frame.TimeSeriesSchema()
+	if schema.Type == data.TimeSeriesTypeNot {
+		return nil, fmt.Errorf("can not downsample frame %q, it is not a time series", frame.Name)
+	}
+	if opts.MaxDataPoints <= 0 {
+		return frame, nil
+	}
+
+	times, err := schema.UnixNanoTimes(frame)
+	if err != nil {
+		return nil, err
+	}
+	series, err := schema.SeriesRows(frame)
+	if err != nil {
+		return nil, err
+	}
+	if !exceeds(series, opts.MaxDataPoints) {
+		return frame, nil
+	}
+
+	values, err := numericValues(frame, schema, opts.Method == Average)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketCount := opts.MaxDataPoints / perBucket
+	if bucketCount < 1 {
+		bucketCount = 1
+	}
+	b := newBuckets(times[0], times[len(times)-1], bucketCount, opts.Interval)
+
+	if opts.Method == Average {
+		return average(frame, schema, series, values, times, b)
+	}
+
+	selected := make([]bool, len(times))
+	for _, rows := range series {
+		for _, v := range values {
+			points := nonNullPoints(rows, v.values)
+			var picked []int
+			switch opts.Method {
+			case LTTB:
+				picked = lttb(points, times, v.values, lttbThreshold(opts, times))
+			case M4:
+				picked = m4(points, times, v.values, b)
+			case MinMax:
+				picked = minMax(points, times, v.values, b)
+			}
+			for _, rowIdx := range picked {
+				selected[rowIdx] = true
+			}
+		}
+	}
+	return selectRows(frame, selected), nil
+}
+
+// exceeds returns true if any series has more than maxDataPoints points.
+func exceeds(series [][]int, maxDataPoints int64) bool {
+	for _, rows := range series {
+		if int64(len(rows)) > maxDataPoints {
+			return true
+		}
+	}
+	return false
+}
+
+// valueField holds the values of a numeric value Field of the frame, with NaN for null values.
+type valueField struct {
+	fieldIdx int
+	values   []float64
+}
+
+// numericValues reads the numeric value Fields of frame. If numericOnly is true, an error is
+// returned if the frame has value Fields that are not numeric.
+func numericValues(frame *data.Frame, schema data.TimeSeriesSchema, numericOnly bool) ([]valueField, error) {
+	var values []valueField
+	for _, fieldIdx := range schema.ValueIndices {
+		field := frame.Fields[fieldIdx]
+		if !field.Type().Numeric() {
+			if numericOnly {
+				return nil, fmt.Errorf("can not average field %q of type %s, a numeric field is required", field.Name, field.Type())
+			}
+			continue
+		}
+		v := valueField{fieldIdx: fieldIdx, values: make([]float64, field.Len())}
+		for i := range v.values {
+			f, err := field.NullableFloatAt(i)
+			if err != nil {
+				return nil, err
+			}
+			if f == nil {
+				v.values[i] = math.NaN()
+				continue
+			}
+			v.values[i] = *f
+		}
+		values = append(values, v)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("can not downsample frame %q, it has no numeric fields", frame.Name)
+	}
+	return values, nil
+}
+
+// nonNullPoints returns the rows that have a value that is not null or NaN.
+func nonNullPoints(rows []int, values []float64) []int {
+	points := make([]int, 0, len(rows))
+	for _, rowIdx := range rows {
+		if !math.IsNaN(values[rowIdx]) {
+			points = append(points, rowIdx)
+		}
+	}
+	return points
+}
+
+// buckets are consecutive time ranges of the same width, in Unix nanoseconds.
+type buckets struct {
+	start, width int64
+}
+
+// newBuckets returns no more than count buckets that cover first to last. The buckets are a
+// multiple of interval, or of a millisecond if interval is smaller, wide and aligned to it.
+func newBuckets(first, last int64, count int64, interval time.Duration) buckets {
+	iv := int64(interval)
+	if iv < int64(time.Millisecond) {
+		iv = int64(time.Millisecond)
+	}
+	start := first - first%iv
+	if first%iv < 0 {
+		start -= iv
+	}
+	width := (last-start)/count + 1
+	if width%iv != 0 {
+		width += iv - width%iv
+	}
+	return buckets{start: start, width: width}
+}
+
+func (b buckets) index(t int64) int64 {
+	return (t - b.start) / b.width
+}
+
+func (b buckets) time(idx int64) time.Time {
+	return time.Unix(0, b.start+idx*b.width)
+}
+
+// lttbThreshold returns the number of points LTTB selects from a series.
+func lttbThreshold(opts Options, times []int64) int64 {
+	threshold := opts.MaxDataPoints
+	if opts.Interval > 0 {
+		if n := (times[len(times)-1]-times[0])/int64(opts.Interval) + 1; n < threshold {
+			threshold = n
+		}
+	}
+	return threshold
+}
+
+// lttb selects threshold of the points with the Largest-Triangle-Three-Buckets algorithm.
+func lttb(points []int, times []int64, values []float64, threshold int64) []int {
+	n := len(points)
+	if int64(n) <= threshold {
+		return points
+	}
+	if threshold < 3 {
+		if threshold == 1 {
+			return points[:1]
+		}
+		return []int{points[0], points[n-1]}
+	}
+
+	// x values are milliseconds since the first point, to keep the triangle areas in float64 range.
+	x := func(i int) float64 {
+		return float64(times[points[i]]-times[points[0]]) / float64(time.Millisecond)
+	}
+	y := func(i int) float64 {
+		return values[points[i]]
+	}
+
+	sampled := make([]int, 0, threshold)
+	sampled = append(sampled, points[0])
+	every := float64(n-2) / float64(threshold-2)
+	a := 0
+	for i := 0; i < int(threshold)-2; i++ {
+		// the average point of the next bucket is the third point of the triangle
+		avgStart := int(float64(i+1)*every) + 1
+		avgEnd := int(float64(i+2)*every) + 1
+		if avgEnd > n {
+			avgEnd = n
+		}
+		var avgX, avgY float64
+		for j := avgStart; j < avgEnd; j++ {
+			avgX += x(j)
+			avgY += y(j)
+		}
+		avgX /= float64(avgEnd - avgStart)
+		avgY /= float64(avgEnd - avgStart)
+
+		rangeStart := int(float64(i)*every) + 1
+		rangeEnd := int(float64(i+1)*every) + 1
+		maxArea, next := -1.0, rangeStart
+		for j := rangeStart; j < rangeEnd; j++ {
+			area := math.Abs((x(a)-avgX)*(y(j)-y(a)) - (x(a)-x(j))*(avgY-y(a)))
+			if area > maxArea {
+				maxArea, next = area, j
+			}
+		}
+		sampled = append(sampled, points[next])
+		a = next
+	}
+	return append(sampled, points[n-1])
+}
+
+// bucketExtremes holds the first, last, smallest and largest point of a bucket.
+type bucketExtremes struct {
+	first, last, min, max int
+}
+
+// forEachBucket calls f with the extremes of each bucket that has points.
+func forEachBucket(points []int, times []int64, values []float64, b buckets, f func(e bucketExtremes)) {
+	if len(points) == 0 {
+		return
+	}
+	e := bucketExtremes{points[0], points[0], points[0], points[0]}
+	current := b.index(times[points[0]])
+	for _, rowIdx := range points[1:] {
+		if idx := b.index(times[rowIdx]); idx != current {
+			f(e)
+			e = bucketExtremes{rowIdx, rowIdx, rowIdx, rowIdx}
+			current = idx
+			continue
+		}
+		e.last = rowIdx
+		if values[rowIdx] < values[e.min] {
+			e.min = rowIdx
+		}
+		if values[rowIdx] > values[e.max] {
+			e.max = rowIdx
+		}
+	}
+	f(e)
+}
+
+// m4 selects the first, last, smallest and largest point of each bucket.
+func m4(points []int, times []int64, values []float64, b buckets) []int {
+	var picked []int
+	forEachBucket(points, times, values, b, func(e bucketExtremes) {
+		picked = append(picked, e.first, e.last, e.min, e.max)
+	})
+	return picked
+}
+
+// minMax selects the smallest and largest point of each bucket.
+func minMax(points []int, times []int64, values []float64, b buckets) []int {
+	var picked []int
+	forEachBucket(points, times, values, b, func(e bucketExtremes) {
+		picked = append(picked, e.min, e.max)
+	})
+	return picked
+}
+
+// selectRows returns a new Frame with the rows of frame that are selected.
+func selectRows(frame *data.Frame, selected []bool) *data.Frame {
+	var rows []int
+	for rowIdx, ok := range selected {
+		if ok {
+			rows = append(rows, rowIdx)
+		}
+	}
+
+	out := frame.CopyWithoutFields()
+	for _, field := range frame.Fields {
+		f := field.EmptyCopyOfType(field.Type())
+		f.Extend(len(rows))
+		for i, rowIdx := range rows {
+			f.Set(i, field.CopyAt(rowIdx))
+		}
+		out.Fields = append(out.Fields, f)
+	}
+	return out
+}
+
+// bucketRows are the rows of a series in a bucket.
+type bucketRows struct {
+	bucket int64
+	series int
+	rows   []int
+}
+
+// average returns a Frame with a row for each bucket of each series that has rows, with the mean
+// of the values of the rows.
+func average(frame *data.Frame, schema data.TimeSeriesSchema, series [][]int, values []valueField, times []int64, b buckets) (*data.Frame, error) {
+	var groups []bucketRows
+	for seriesIdx, rows := range series {
+		for _, rowIdx := range rows {
+			idx := b.index(times[rowIdx])
+			if n := len(groups); n > 0 && groups[n-1].series == seriesIdx && groups[n-1].bucket == idx {
+				groups[n-1].rows = append(groups[n-1].rows, rowIdx)
+				continue
+			}
+			groups = append(groups, bucketRows{bucket: idx, series: seriesIdx, rows: []int{rowIdx}})
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].bucket < groups[j].bucket
+	})
+
+	valuesByField := make(map[int][]float64, len(values))
+	for _, v := range values {
+		valuesByField[v.fieldIdx] = v.values
+	}
+
+	out := frame.CopyWithoutFields()
+	for fieldIdx, field := range frame.Fields {
+		fieldValues, isValue := valuesByField[fieldIdx]
+		ft := field.Type()
+		if isValue {
+			ft = data.FieldTypeNullableFloat64
+		}
+		f := field.EmptyCopyOfType(ft)
+		f.Extend(len(groups))
+		for i, g := range groups {
+			switch {
+			case fieldIdx == schema.TimeIndex:
+				f.SetConcrete(i, b.time(g.bucket))
+			case isValue:
+				f.Set(i, mean(g.rows, fieldValues))
+			default:
+				f.Set(i, field.CopyAt(g.rows[0]))
+			}
+		}
+		out.Fields = append(out.Fields, f)
+	}
+	return out, nil
+}
+
+// mean returns the mean of the values of rows that are not null or NaN, or nil if there are none.
+func mean(rows []int, values []float64) *float64 {
+	var sum float64
+	var count int
+	for _, rowIdx := range rows {
+		if v := values[rowIdx]; !math.IsNaN(v) {
+			sum += v
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	m := sum / float64(count)
+	return &m
+}
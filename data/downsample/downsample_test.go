@@ -0,0 +1,160 @@
+package downsample_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/downsample"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func seconds(s ...int64) []time.Time {
+	times := make([]time.Time, len(s))
+	for i, v := range s {
+		times[i] = time.Unix(v, 0)
+	}
+	return times
+}
+
+func TestFrameUnchanged(t *testing.T) {
+	frame := data.NewFrame("",
+		data.NewField("time", nil, seconds(0, 1, 2)),
+		data.NewField("value", nil, []float64{1, 2, 3}),
+	)
+	for _, opts := range []downsample.Options{{MaxDataPoints: 3}, {MaxDataPoints: 0}} {
+		out, err := downsample.Frame(frame, opts)
+		require.NoError(t, err)
+		require.Same(t, frame, out)
+	}
+}
+
+func TestLTTB(t *testing.T) {
+	n := 100
+	times := make([]time.Time, n)
+	values := make([]float64, n)
+	for i := range times {
+		times[i] = time.Unix(int64(i), 0)
+		values[i] = math.Sin(float64(i) / 10)
+	}
+	values[42] = 100
+	frame := data.NewFrame("",
+		data.NewField("time", nil, times),
+		data.NewField("value", data.Labels{"a": "b"}, values),
+	)
+
+	out, err := downsample.Frame(frame, downsample.Options{Method: downsample.LTTB, MaxDataPoints: 10})
+	require.NoError(t, err)
+	require.Equal(t, 10, out.Rows())
+	require.Equal(t, times[0], out.Fields[0].At(0))
+	require.Equal(t, times[n-1], out.Fields[0].At(9))
+	sampled, ok := data.Values[float64](out.Fields[1])
+	require.True(t, ok)
+	require.Contains(t, sampled, 100.0)
+	require.Equal(t, data.Labels{"a": "b"}, out.Fields[1].Labels)
+
+	// the interval limits the points to one per 20s
+	out, err = downsample.Frame(frame, downsample.Options{MaxDataPoints: 10, Interval: 20 * time.Second})
+	require.NoError(t, err)
+	require.Equal(t, 5, out.Rows())
+}
+
+func TestM4AndMinMax(t *testing.T) {
+	frame := data.NewFrame("wide",
+		data.NewField("time", nil, seconds(0, 1, 2, 3, 4, 5, 6, 7)),
+		data.NewField("a", nil, []*float64{ptr(1.0), ptr(5.0), ptr(0.0), ptr(2.0), ptr(3.0), nil, ptr(9.0), ptr(4.0)}),
+	)
+
+	// M4 with a single bucket selects the first, last, smallest and largest point
+	out, err := downsample.Frame(frame, downsample.Options{Method: downsample.M4, MaxDataPoints: 7})
+	require.NoError(t, err)
+	expected := data.NewFrame("wide",
+		data.NewField("time", nil, seconds(0, 2, 6, 7)),
+		data.NewField("a", nil, []*float64{ptr(1.0), ptr(0.0), ptr(9.0), ptr(4.0)}),
+	)
+	if diff := cmp.Diff(expected, out, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	// MinMax with 3 buckets of at least 7s/3, rounded up to a multiple of the 2s interval: [0s, 4s) and [4s, 8s)
+	out, err = downsample.Frame(frame, downsample.Options{Method: downsample.MinMax, MaxDataPoints: 7, Interval: 2 * time.Second})
+	require.NoError(t, err)
+	expected = data.NewFrame("wide",
+		data.NewField("time", nil, seconds(1, 2, 4, 6)),
+		data.NewField("a", nil, []*float64{ptr(5.0), ptr(0.0), ptr(3.0), ptr(9.0)}),
+	)
+	if diff := cmp.Diff(expected, out, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	out, err = downsample.Frame(frame, downsample.Options{Method: downsample.MinMax, MaxDataPoints: 2})
+	require.NoError(t, err)
+	expected = data.NewFrame("wide",
+		data.NewField("time", nil, seconds(2, 6)),
+		data.NewField("a", nil, []*float64{ptr(0.0), ptr(9.0)}),
+	)
+	if diff := cmp.Diff(expected, out, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAverageLong(t *testing.T) {
+	frame := data.NewFrame("long",
+		data.NewField("time", nil, seconds(0, 0, 1, 1, 2, 2, 3, 3)),
+		data.NewField("host", nil, []string{"a", "b", "a", "b", "a", "b", "a", "b"}),
+		data.NewField("value", nil, []int64{1, 10, 3, 20, 5, 30, 7, 40}),
+	)
+	frame.RefID = "A"
+
+	out, err := downsample.Frame(frame, downsample.Options{Method: downsample.Average, MaxDataPoints: 2, Interval: time.Second})
+	require.NoError(t, err)
+	expected := data.NewFrame("long",
+		data.NewField("time", nil, seconds(0, 0, 2, 2)),
+		data.NewField("host", nil, []string{"a", "b", "a", "b"}),
+		data.NewField("value", nil, []*float64{ptr(2.0), ptr(15.0), ptr(6.0), ptr(35.0)}),
+	)
+	expected.RefID = "A"
+	if diff := cmp.Diff(expected, out, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	frames, err := downsample.Frames(data.Frames{frame, data.NewFrame("table", data.NewField("name", nil, []string{"x"}))},
+		downsample.Options{Method: downsample.Average, MaxDataPoints: 2})
+	require.NoError(t, err)
+	require.Len(t, frames, 2)
+	require.Equal(t, 4, frames[0].Rows())
+	require.Equal(t, "table", frames[1].Name)
+}
+
+func TestFrameErrors(t *testing.T) {
+	unsorted := data.NewFrame("",
+		data.NewField("time", nil, seconds(1, 0)),
+		data.NewField("value", nil, []float64{1, 2}),
+	)
+	_, err := downsample.Frame(unsorted, downsample.Options{MaxDataPoints: 1})
+	require.Error(t, err)
+
+	sorted := data.NewFrame("",
+		data.NewField("time", nil, seconds(0, 1)),
+		data.NewField("value", nil, []float64{1, 2}),
+	)
+	_, err = downsample.Frame(sorted, downsample.Options{Method: "unknown", MaxDataPoints: 1})
+	require.Error(t, err)
+
+	_, err = downsample.Frame(data.NewFrame("", data.NewField("value", nil, []float64{1, 2})), downsample.Options{MaxDataPoints: 1})
+	require.Error(t, err)
+
+	enum := data.NewFrame("",
+		data.NewField("time", nil, seconds(0, 1)),
+		data.NewField("value", nil, []data.EnumItemIndex{1, 2}),
+	)
+	_, err = downsample.Frame(enum, downsample.Options{Method: downsample.Average, MaxDataPoints: 1})
+	require.Error(t, err)
+}
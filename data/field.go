@@ -62,6 +62,10 @@ type Fields []*Field
 //
 //	[]data.EnumItemIndex, []*data.EnumItemIndex
 //
+// Decimal and Duration:
+//
+//	[]data.Decimal, []*data.Decimal, []time.Duration, []*time.Duration
+//
 // If an unsupported values type is passed, NewField will panic.
 // nolint:gocyclo
 func NewField(name string, labels Labels, values interface{}) *Field {
@@ -127,6 +131,14 @@ func NewField(name string, labels Labels, values interface{}) *Field {
 		vec = newEnumVectorWithValues(v)
 	case []*EnumItemIndex:
 		vec = newNullableEnumVectorWithValues(v)
+	case []Decimal:
+		vec = newDecimalVectorWithValues(v)
+	case []*Decimal:
+		vec = newNullableDecimalVectorWithValues(v)
+	case []time.Duration:
+		vec = newDurationVectorWithValues(v)
+	case []*time.Duration:
+		vec = newNullableDurationVectorWithValues(v)
 	default:
 		panic(fmt.Errorf("field '%s' specified with unsupported type %T", name, v))
 	}
@@ -273,6 +285,28 @@ func (f *Field) SetConfig(conf *FieldConfig) *Field {
 	return f
 }
 
+// Copy returns a copy of Field f with a copy of its values and Labels. The Config is shared.
+func (f *Field) Copy() *Field {
+	out := f.EmptyCopyOfType(f.Type())
+	out.Extend(f.Len())
+	for i := 0; i < f.Len(); i++ {
+		out.Set(i, f.CopyAt(i))
+	}
+	return out
+}
+
+// EmptyCopyOfType returns a Field of type ft and length zero with the Name and Config of Field f
+// and a copy of its Labels. The Config is shared.
+func (f *Field) EmptyCopyOfType(ft FieldType) *Field {
+	out := NewFieldFromFieldType(ft, 0)
+	out.Name = f.Name
+	if f.Labels != nil {
+		out.Labels = f.Labels.Copy()
+	}
+	out.Config = f.Config
+	return out
+}
+
 // FloatAt returns a float64 at the specified index idx for all supported Field types.
 // It will panic if idx is out of range.
 //
@@ -284,10 +318,17 @@ func (f *Field) SetConfig(conf *FieldConfig) *Field {
 // If the Field type is time.Time, then the millisecond epoch representation of the time
 // is returned, or NaN is the value is nil.
 //
+// If the Field type is time.Duration, then the duration in milliseconds is returned,
+// or NaN if the value is nil.
+//
 // If the Field type is a string, then strconv.ParseFloat is called on it and will return
 // an error if ParseFloat errors. If the value is nil, NaN is returned.
 // nolint:gocyclo
 func (f *Field) FloatAt(idx int) (float64, error) {
+	if fv, ok := numericFloatAt(f.vector, idx); ok {
+		return fv, nil
+	}
+
 	switch f.Type() {
 	case FieldTypeInt8:
 		return float64(f.At(idx).(int8)), nil
@@ -420,6 +461,24 @@ func (f *Field) FloatAt(idx int) (float64, error) {
 			return math.NaN(), nil
 		}
 		return float64(t.UnixNano() / int64(time.Millisecond)), nil
+
+	case FieldTypeDecimal:
+		return f.At(idx).(Decimal).Float64(), nil
+	case FieldTypeNullableDecimal:
+		d := f.At(idx).(*Decimal)
+		if d == nil {
+			return math.NaN(), nil
+		}
+		return d.Float64(), nil
+
+	case FieldTypeDuration:
+		return durationMillis(f.At(idx).(time.Duration)), nil
+	case FieldTypeNullableDuration:
+		d := f.At(idx).(*time.Duration)
+		if d == nil {
+			return math.NaN(), nil
+		}
+		return durationMillis(*d), nil
 	}
 	return 0, fmt.Errorf("unsupported field type %T", f.Type())
 }
@@ -545,6 +604,27 @@ func (f *Field) NullableFloatAt(idx int) (*float64, error) {
 		}
 		f := float64(t.UnixNano() / int64(time.Millisecond))
 		return &f, nil
+
+	case FieldTypeNullableDecimal:
+		d := f.At(idx).(*Decimal)
+		if d == nil {
+			return nil, nil
+		}
+		f := d.Float64()
+		return &f, nil
+
+	case FieldTypeNullableDuration:
+		d := f.At(idx).(*time.Duration)
+		if d == nil {
+			return nil, nil
+		}
+		f := durationMillis(*d)
+		return &f, nil
 	}
 	return nil, fmt.Errorf("unsupported field type %T", f.Type())
 }
+
+// durationMillis returns d in milliseconds, keeping fractions of a millisecond.
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
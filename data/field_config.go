@@ -260,3 +260,24 @@ const (
 	// ThresholdsModePercentage the threshold is relative to min/max
 	ThresholdsModePercentage ThresholdsMode = "percentage"
 )
+
+// ActiveStep returns the Threshold step of value, which is the last step whose Value is less than
+// or equal to value, or the first step if there is none, like the frontend. In percentage mode,
+// value is first converted to a percentage of the range from min to max.
+// If there are no Steps, ok is false.
+func (tc *ThresholdsConfig) ActiveStep(value, minValue, maxValue float64) (step Threshold, ok bool) {
+	if tc == nil || len(tc.Steps) == 0 {
+		return Threshold{}, false
+	}
+	if tc.Mode == ThresholdsModePercentage {
+		value = (value - minValue) / (maxValue - minValue) * 100
+	}
+	step = tc.Steps[0]
+	for _, s := range tc.Steps[1:] {
+		if !(value >= float64(s.Value)) {
+			break
+		}
+		step = s
+	}
+	return step, true
+}
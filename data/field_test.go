@@ -147,3 +147,29 @@ func TestTimeField(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldEmptyCopyOfType(t *testing.T) {
+	config := &data.FieldConfig{Unit: "percent"}
+	field := data.NewField("cpu", data.Labels{"host": "a"}, []int64{1, 2}).SetConfig(config)
+
+	out := field.EmptyCopyOfType(data.FieldTypeNullableFloat64)
+	require.Equal(t, data.FieldTypeNullableFloat64, out.Type())
+	require.Equal(t, 0, out.Len())
+	require.Equal(t, "cpu", out.Name)
+	require.Same(t, config, out.Config)
+
+	out.Labels["host"] = "b"
+	require.Equal(t, data.Labels{"host": "a"}, field.Labels)
+}
+
+func TestFieldCopy(t *testing.T) {
+	field := data.NewField("cpu", data.Labels{"host": "a"}, []*float64{nil, float64Ptr(2)})
+
+	out := field.Copy()
+	require.Equal(t, field, out)
+
+	*out.At(1).(*float64) = 3
+	out.Labels["host"] = "b"
+	require.Equal(t, 2.0, *field.At(1).(*float64))
+	require.Equal(t, data.Labels{"host": "a"}, field.Labels)
+}
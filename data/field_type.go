@@ -91,6 +91,16 @@ const (
 	FieldTypeEnum
 	// FieldTypeNullableEnum indicates the underlying primitive is a []*data.EnumItemIndex, with field mapping metadata
 	FieldTypeNullableEnum
+
+	// FieldTypeDecimal indicates the underlying primitive is a []data.Decimal.
+	FieldTypeDecimal
+	// FieldTypeNullableDecimal indicates the underlying primitive is a []*data.Decimal.
+	FieldTypeNullableDecimal
+
+	// FieldTypeDuration indicates the underlying primitive is a []time.Duration.
+	FieldTypeDuration
+	// FieldTypeNullableDuration indicates the underlying primitive is a []*time.Duration.
+	FieldTypeNullableDuration
 )
 
 // MarshalJSON marshals the enum as a quoted json string
@@ -200,6 +210,16 @@ func FieldTypeFor(item interface{}) FieldType {
 		return FieldTypeEnum
 	case *EnumItemIndex:
 		return FieldTypeNullableEnum
+
+	case Decimal:
+		return FieldTypeDecimal
+	case *Decimal:
+		return FieldTypeNullableDecimal
+
+	case time.Duration:
+		return FieldTypeDuration
+	case *time.Duration:
+		return FieldTypeNullableDuration
 	}
 
 	return FieldTypeUnknown
@@ -258,6 +278,12 @@ func (p FieldType) NullableType() FieldType {
 	case FieldTypeEnum, FieldTypeNullableEnum:
 		return FieldTypeNullableEnum
 
+	case FieldTypeDecimal, FieldTypeNullableDecimal:
+		return FieldTypeNullableDecimal
+
+	case FieldTypeDuration, FieldTypeNullableDuration:
+		return FieldTypeNullableDuration
+
 	default:
 		panic(fmt.Sprintf("unsupported vector ptype: %+v", p))
 	}
@@ -315,6 +341,12 @@ func (p FieldType) NonNullableType() FieldType {
 
 	case FieldTypeEnum, FieldTypeNullableEnum:
 		return FieldTypeEnum
+
+	case FieldTypeDecimal, FieldTypeNullableDecimal:
+		return FieldTypeDecimal
+
+	case FieldTypeDuration, FieldTypeNullableDuration:
+		return FieldTypeDuration
 	default:
 		panic(fmt.Sprintf("unsupported vector ptype: %+v", p))
 	}
@@ -399,6 +431,16 @@ func FieldTypeFromItemTypeString(s string) (FieldType, bool) {
 		return FieldTypeEnum, true
 	case "*enum":
 		return FieldTypeNullableEnum, true
+
+	case "decimal":
+		return FieldTypeDecimal, true
+	case "*decimal":
+		return FieldTypeNullableDecimal, true
+
+	case "duration", "time.Duration":
+		return FieldTypeDuration, true
+	case "*time.Duration":
+		return FieldTypeNullableDuration, true
 	}
 
 	return FieldTypeNullableString, false
@@ -483,6 +525,16 @@ func (p FieldType) ItemTypeString() string {
 		return "enum"
 	case FieldTypeNullableEnum:
 		return "*enum"
+
+	case FieldTypeDecimal:
+		return "decimal"
+	case FieldTypeNullableDecimal:
+		return "*decimal"
+
+	case FieldTypeDuration:
+		return "time.Duration"
+	case FieldTypeNullableDuration:
+		return "*time.Duration"
 	}
 	return "invalid/unsupported type"
 }
@@ -552,6 +604,14 @@ func ValidFieldType(t interface{}) bool {
 		return true
 	case []*json.RawMessage:
 		return true
+	case []Decimal:
+		return true
+	case []*Decimal:
+		return true
+	case []time.Duration:
+		return true
+	case []*time.Duration:
+		return true
 	default:
 		return false
 	}
@@ -579,6 +639,9 @@ func (p FieldType) Numeric() bool {
 		return true
 	case FieldTypeNullableFloat32, FieldTypeNullableFloat64:
 		return true
+
+	case FieldTypeDecimal, FieldTypeNullableDecimal:
+		return true
 	}
 	return false
 }
@@ -588,6 +651,11 @@ func (p FieldType) Time() bool {
 	return p == FieldTypeTime || p == FieldTypeNullableTime
 }
 
+// Duration returns if Field type is a duration type (FieldTypeDuration or FieldTypeNullableDuration).
+func (p FieldType) Duration() bool {
+	return p == FieldTypeDuration || p == FieldTypeNullableDuration
+}
+
 // JSON returns if Field type is a json type (FieldTypeJSON or FieldTypeNullableJSON).
 func (p FieldType) JSON() bool {
 	return p == FieldTypeJSON || p == FieldTypeNullableJSON
@@ -602,7 +670,9 @@ var numericFieldTypes = [...]FieldType{
 	FieldTypeNullableUint8, FieldTypeNullableUint16, FieldTypeNullableUint32, FieldTypeNullableUint64,
 
 	FieldTypeFloat32, FieldTypeFloat64,
-	FieldTypeNullableFloat32, FieldTypeNullableFloat64}
+	FieldTypeNullableFloat32, FieldTypeNullableFloat64,
+
+	FieldTypeDecimal, FieldTypeNullableDecimal}
 
 // NumericFieldTypes returns a slice of FieldTypes that are numeric.
 func NumericFieldTypes() []FieldType {
@@ -0,0 +1,144 @@
+package data
+
+import "math"
+
+// Values returns the values of field as a []T without copying them. The bool is false if T is not the
+// Go type of the Field's values: T is the element type of the slice the Field was created from
+// (for example float64 for a FieldTypeFloat64 Field and *float64 for a FieldTypeNullableFloat64 Field).
+//
+// The returned slice shares its memory with the Field, so setting an element sets the Field's value.
+// Appending to the slice does not change the Field; use FieldOf for that.
+func Values[T any](field *Field) ([]T, bool) {
+	values, ok := slicePointerOf[T](field)
+	if !ok {
+		return nil, false
+	}
+	return *values, true
+}
+
+// TypedField gives typed access to the values of a Field, so reading and writing them does not box values
+// into interface{} as the methods of Field do. It is created by FieldOf.
+type TypedField[T any] struct {
+	field  *Field
+	values *[]T
+}
+
+// FieldOf returns a TypedField for field. The bool is false if T is not the Go type of the Field's
+// values (see Values).
+func FieldOf[T any](field *Field) (TypedField[T], bool) {
+	values, ok := slicePointerOf[T](field)
+	if !ok {
+		return TypedField[T]{}, false
+	}
+	return TypedField[T]{field: field, values: values}, true
+}
+
+// Field returns the Field the TypedField gives access to.
+func (f TypedField[T]) Field() *Field {
+	return f.field
+}
+
+// Len returns the number of values of the Field.
+func (f TypedField[T]) Len() int {
+	return len(*f.values)
+}
+
+// At returns the value at idx. It will panic if idx is out of range.
+func (f TypedField[T]) At(idx int) T {
+	return (*f.values)[idx]
+}
+
+// Set sets the value at idx to val. It will panic if idx is out of range.
+func (f TypedField[T]) Set(idx int, val T) {
+	(*f.values)[idx] = val
+}
+
+// Append appends val to the Field.
+func (f TypedField[T]) Append(val T) {
+	*f.values = append(*f.values, val)
+}
+
+// Values returns the values of the Field, see Values.
+func (f TypedField[T]) Values() []T {
+	return *f.values
+}
+
+// slicePointerOf returns a pointer to the slice holding the values of field if its elements are of type T.
+// A Field backed by an Arrow array (see FromArrowRecordZeroCopy) has its values copied into a slice first,
+// since the values can be modified through the slice.
+func slicePointerOf[T any](field *Field) (*[]T, bool) {
+	v := field.vector
+	if av, ok := v.(*arrowVector); ok {
+		if _, ok := slicePointerOf[T](NewFieldFromFieldType(av.Type(), 0)); !ok {
+			return nil, false
+		}
+		v = av.mutable()
+	}
+
+	sv, ok := v.(interface{ slicePointer() interface{} })
+	if !ok {
+		return nil, false
+	}
+	values, ok := sv.slicePointer().(*[]T)
+	return values, ok
+}
+
+type numeric interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// numericFloatAt returns the value at idx of a numeric slice backed vector as a float64 without
+// boxing it into an interface{} (NaN for null values). The bool is false for other vectors.
+// nolint:gocyclo
+func numericFloatAt(v vector, idx int) (float64, bool) {
+	switch v := v.(type) {
+	case *float64Vector:
+		return (*v)[idx], true
+	case *nullableFloat64Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *float32Vector:
+		return float64((*v)[idx]), true
+	case *nullableFloat32Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *int64Vector:
+		return float64((*v)[idx]), true
+	case *nullableInt64Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *int32Vector:
+		return float64((*v)[idx]), true
+	case *nullableInt32Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *int16Vector:
+		return float64((*v)[idx]), true
+	case *nullableInt16Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *int8Vector:
+		return float64((*v)[idx]), true
+	case *nullableInt8Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *uint64Vector:
+		return float64((*v)[idx]), true
+	case *nullableUint64Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *uint32Vector:
+		return float64((*v)[idx]), true
+	case *nullableUint32Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *uint16Vector:
+		return float64((*v)[idx]), true
+	case *nullableUint16Vector:
+		return nullableToFloat((*v)[idx]), true
+	case *uint8Vector:
+		return float64((*v)[idx]), true
+	case *nullableUint8Vector:
+		return nullableToFloat((*v)[idx]), true
+	}
+	return 0, false
+}
+
+func nullableToFloat[T numeric](v *T) float64 {
+	if v == nil {
+		return math.NaN()
+	}
+	return float64(*v)
+}
@@ -0,0 +1,130 @@
+package data_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestValues(t *testing.T) {
+	field := data.NewField("value", nil, []float64{1, 2, 3})
+
+	values, ok := data.Values[float64](field)
+	require.True(t, ok)
+	require.Equal(t, []float64{1, 2, 3}, values)
+
+	values[0] = 10
+	require.Equal(t, 10.0, field.At(0))
+
+	_, ok = data.Values[*float64](field)
+	require.False(t, ok)
+	_, ok = data.Values[int64](field)
+	require.False(t, ok)
+
+	nullable := data.NewField("value", nil, []*int64{nil, int64Ptr(1)})
+	nullableValues, ok := data.Values[*int64](nullable)
+	require.True(t, ok)
+	require.Nil(t, nullableValues[0])
+	require.Equal(t, int64(1), *nullableValues[1])
+
+	enum := data.NewField("enum", nil, []data.EnumItemIndex{1})
+	_, ok = data.Values[uint16](enum)
+	require.False(t, ok)
+	_, ok = data.Values[data.EnumItemIndex](enum)
+	require.True(t, ok)
+}
+
+func TestFieldOf(t *testing.T) {
+	t1 := time.Unix(1, 0)
+	field := data.NewField("time", nil, []time.Time{t1})
+
+	tf, ok := data.FieldOf[time.Time](field)
+	require.True(t, ok)
+	require.Same(t, field, tf.Field())
+
+	tf.Append(t1.Add(time.Second))
+	require.Equal(t, 2, field.Len())
+	require.Equal(t, t1.Add(time.Second), field.At(1))
+
+	tf.Set(0, t1.Add(-time.Second))
+	require.Equal(t, t1.Add(-time.Second), tf.At(0))
+	require.Equal(t, []time.Time{t1.Add(-time.Second), t1.Add(time.Second)}, tf.Values())
+
+	_, ok = data.FieldOf[string](field)
+	require.False(t, ok)
+}
+
+func TestValuesArrowBackedField(t *testing.T) {
+	b, err := data.NewFrame("", data.NewField("value", nil, []float64{1, 2})).MarshalArrow()
+	require.NoError(t, err)
+	frame, err := data.UnmarshalArrowFrameZeroCopy(b)
+	require.NoError(t, err)
+
+	_, ok := data.Values[string](frame.Fields[0])
+	require.False(t, ok)
+
+	values, ok := data.Values[float64](frame.Fields[0])
+	require.True(t, ok)
+	values[0] = 10
+	require.Equal(t, 10.0, frame.Fields[0].At(0))
+}
+
+func TestFloatAtNullable(t *testing.T) {
+	field := data.NewField("value", nil, []*uint32{nil, uint32Ptr(2)})
+	v, err := field.FloatAt(0)
+	require.NoError(t, err)
+	require.True(t, math.IsNaN(v))
+	v, err = field.FloatAt(1)
+	require.NoError(t, err)
+	require.Equal(t, 2.0, v)
+}
+
+func benchmarkFloat64Field(n int) *data.Field {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = float64(i) + 0.5
+	}
+	return data.NewField("value", nil, values)
+}
+
+func BenchmarkFieldAtFloat64Sum(b *testing.B) {
+	field := benchmarkFloat64Field(100_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum float64
+		for j := 0; j < field.Len(); j++ {
+			sum += field.At(j).(float64)
+		}
+	}
+}
+
+func BenchmarkFieldFloatAtSum(b *testing.B) {
+	field := benchmarkFloat64Field(100_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum float64
+		for j := 0; j < field.Len(); j++ {
+			v, _ := field.FloatAt(j)
+			sum += v
+		}
+	}
+}
+
+func BenchmarkValuesFloat64Sum(b *testing.B) {
+	field := benchmarkFloat64Field(100_000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		values, _ := data.Values[float64](field)
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+	}
+}
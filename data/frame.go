@@ -186,6 +186,21 @@ func (f *Frame) EmptyCopy() *Frame {
 	return newFrame
 }
 
+// CopyWithoutFields returns a Frame with the Name and RefID of Frame f, a shallow copy of its Meta,
+// and no Fields, with capacity for as many Fields as f.
+func (f *Frame) CopyWithoutFields() *Frame {
+	newFrame := &Frame{
+		Name:   f.Name,
+		RefID:  f.RefID,
+		Fields: make(Fields, 0, len(f.Fields)),
+	}
+	if f.Meta != nil {
+		meta := *f.Meta
+		newFrame.Meta = &meta
+	}
+	return newFrame
+}
+
 // ZeroLength sets the length of every field to zero
 // This offers an efficient way to reuse the existing allocated slice in multiple data "pages"
 func (f *Frame) Clear() {
@@ -453,8 +468,20 @@ func FrameTestCompareOptions() []cmp.Option {
 		return bytes.Equal(xJSON, yJSON)
 	})
 
+	// Fields backed by arrow arrays are compared by their values.
+	arrowFields := cmp.Transformer("arrowValues", func(f *Field) *Field {
+		if f == nil {
+			return nil
+		}
+		v, ok := f.vector.(*arrowVector)
+		if !ok {
+			return f
+		}
+		return &Field{Name: f.Name, Labels: f.Labels, Config: f.Config, vector: v.vectorForArrow()}
+	})
+
 	unexportedField := cmp.AllowUnexported(Field{})
-	return []cmp.Option{f32s, f32Ptrs, f64s, f64Ptrs, confFloats, metas, rawjs, unexportedField, cmpopts.EquateEmpty()}
+	return []cmp.Option{f32s, f32Ptrs, f64s, f64Ptrs, confFloats, metas, rawjs, arrowFields, unexportedField, cmpopts.EquateEmpty()}
 }
 
 const maxLengthExceededStr = "..."
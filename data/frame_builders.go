@@ -0,0 +1,357 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// LogLine is a row of a logs frame built by LogsFrameBuilder.
+type LogLine struct {
+	// Timestamp is the time of the line. It is required.
+	Timestamp time.Time
+
+	// Body is the content of the line.
+	Body string
+
+	// Severity is the level of the line, such as "info" or "error". It is optional.
+	Severity string
+
+	// ID uniquely identifies the line. It is optional, but if one line has an ID every line must have
+	// a different one.
+	ID string
+
+	// Labels are the labels of the line. They are optional.
+	Labels Labels
+}
+
+// LogsFrameBuilder builds a logs frame of type FrameTypeLogLines, as documented in the
+// [Logs Format in the Data Plane Contract], that is shown as logs in Explore.
+//
+// [Logs Format in the Data Plane Contract]: https://grafana.github.io/dataplane/contract/logs
+type LogsFrameBuilder struct {
+	name  string
+	lines []LogLine
+}
+
+// NewLogsFrameBuilder returns a LogsFrameBuilder for a frame with the given name.
+func NewLogsFrameBuilder(name string) *LogsFrameBuilder {
+	return &LogsFrameBuilder{name: name}
+}
+
+// Add adds lines to the frame.
+func (b *LogsFrameBuilder) Add(lines ...LogLine) *LogsFrameBuilder {
+	b.lines = append(b.lines, lines...)
+	return b
+}
+
+// Frame returns the logs frame. It has the Fields "timestamp" and "body", and the Fields
+// "severity", "id" and "labels" if any line has a value for them.
+// An error is returned if a line has no Timestamp, or if only some lines have an ID or an ID is not unique.
+func (b *LogsFrameBuilder) Frame() (*Frame, error) {
+	var hasSeverity, hasID, hasLabels bool
+	for _, line := range b.lines {
+		hasSeverity = hasSeverity || line.Severity != ""
+		hasID = hasID || line.ID != ""
+		hasLabels = hasLabels || len(line.Labels) > 0
+	}
+
+	ids := make(map[string]int)
+	for i, line := range b.lines {
+		if line.Timestamp.IsZero() {
+			return nil, fmt.Errorf("log line %d has no timestamp", i)
+		}
+		if !hasID {
+			continue
+		}
+		if line.ID == "" {
+			return nil, fmt.Errorf("log line %d has no id, but other lines have one", i)
+		}
+		if prev, ok := ids[line.ID]; ok {
+			return nil, fmt.Errorf("log line %d has the same id %q as line %d", i, line.ID, prev)
+		}
+		ids[line.ID] = i
+	}
+
+	rows := len(b.lines)
+	timestamp := NewFieldFromFieldType(FieldTypeTime, rows)
+	timestamp.Name = "timestamp"
+	body := NewFieldFromFieldType(FieldTypeString, rows)
+	body.Name = "body"
+	severity := NewFieldFromFieldType(FieldTypeString, rows)
+	severity.Name = "severity"
+	id := NewFieldFromFieldType(FieldTypeString, rows)
+	id.Name = "id"
+	labels := NewFieldFromFieldType(FieldTypeJSON, rows)
+	labels.Name = "labels"
+
+	for i, line := range b.lines {
+		timestamp.Set(i, line.Timestamp)
+		body.Set(i, line.Body)
+		severity.Set(i, line.Severity)
+		id.Set(i, line.ID)
+		lineLabels := line.Labels
+		if lineLabels == nil {
+			lineLabels = Labels{}
+		}
+		raw, err := json.Marshal(lineLabels)
+		if err != nil {
+			return nil, fmt.Errorf("log line %d: %w", i, err)
+		}
+		labels.Set(i, json.RawMessage(raw))
+	}
+
+	frame := NewFrame(b.name, timestamp, body).SetMeta(&FrameMeta{
+		Type:                   FrameTypeLogLines,
+		TypeVersion:            FrameTypeVersion{0, 0},
+		PreferredVisualization: VisTypeLogs,
+	})
+	if hasSeverity {
+		frame.Fields = append(frame.Fields, severity)
+	}
+	if hasID {
+		frame.Meta.UniqueRowIDFields = []int{len(frame.Fields)}
+		frame.Fields = append(frame.Fields, id)
+	}
+	if hasLabels {
+		frame.Fields = append(frame.Fields, labels)
+	}
+	return frame, nil
+}
+
+// TraceKeyValue is a tag of a span, of the service of a span, or of a span log.
+type TraceKeyValue struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// TraceLog is an event of a span.
+type TraceLog struct {
+	Timestamp time.Time
+	Name      string
+	Fields    []TraceKeyValue
+}
+
+// MarshalJSON writes the log in the format of the trace view, with the timestamp in epoch milliseconds.
+func (l TraceLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Timestamp float64         `json:"timestamp"`
+		Name      string          `json:"name,omitempty"`
+		Fields    []TraceKeyValue `json:"fields"`
+	}{
+		Timestamp: float64(l.Timestamp.UnixNano()) / float64(time.Millisecond),
+		Name:      l.Name,
+		Fields:    emptyIfNil(l.Fields),
+	})
+}
+
+// TraceReference is a link from a span to another span, possibly of another trace.
+type TraceReference struct {
+	TraceID string          `json:"traceID"`
+	SpanID  string          `json:"spanID"`
+	Tags    []TraceKeyValue `json:"tags,omitempty"`
+}
+
+// TraceSpan is a row of a trace frame built by TraceFrameBuilder.
+type TraceSpan struct {
+	// TraceID and SpanID identify the span. They are required.
+	TraceID string
+	SpanID  string
+
+	// ParentSpanID is the SpanID of the parent span, empty for a root span.
+	ParentSpanID string
+
+	OperationName string
+	ServiceName   string
+	ServiceTags   []TraceKeyValue
+
+	// StartTime is the start of the span. It is required.
+	StartTime time.Time
+	Duration  time.Duration
+
+	Tags       []TraceKeyValue
+	Logs       []TraceLog
+	References []TraceReference
+
+	// Kind is the kind of span, such as "server" or "client".
+	Kind string
+
+	// StatusCode is the status of the span: 0 is unset, 1 ok and 2 error.
+	StatusCode    int64
+	StatusMessage string
+}
+
+// TraceFrameBuilder builds a trace frame, with one row per span, that is shown in the trace view.
+// The data plane contract has no frame type for traces, so the frame's Meta.Type is not set:
+// the trace view recognizes the frame by its Meta.PreferredVisualization, VisTypeTrace.
+type TraceFrameBuilder struct {
+	name  string
+	spans []TraceSpan
+}
+
+// NewTraceFrameBuilder returns a TraceFrameBuilder for a frame with the given name.
+func NewTraceFrameBuilder(name string) *TraceFrameBuilder {
+	return &TraceFrameBuilder{name: name}
+}
+
+// Add adds spans to the frame.
+func (b *TraceFrameBuilder) Add(spans ...TraceSpan) *TraceFrameBuilder {
+	b.spans = append(b.spans, spans...)
+	return b
+}
+
+// Frame returns the trace frame. Its Fields are "traceID", "spanID", "parentSpanID",
+// "operationName", "serviceName", "serviceTags", "startTime" and "duration", in epoch milliseconds
+// and milliseconds, "logs", "references", "tags", "kind", "statusCode" and "statusMessage".
+// An error is returned if a span has no TraceID, SpanID or StartTime, if a span has a negative
+// Duration, or if a SpanID is not unique within its trace.
+func (b *TraceFrameBuilder) Frame() (*Frame, error) {
+	type spanKey struct{ traceID, spanID string }
+	seen := make(map[spanKey]int, len(b.spans))
+	for i, span := range b.spans {
+		switch {
+		case span.TraceID == "":
+			return nil, fmt.Errorf("span %d has no trace id", i)
+		case span.SpanID == "":
+			return nil, fmt.Errorf("span %d has no span id", i)
+		case span.StartTime.IsZero():
+			return nil, fmt.Errorf("span %d (%s) has no start time", i, span.SpanID)
+		case span.Duration < 0:
+			return nil, fmt.Errorf("span %d (%s) has a negative duration", i, span.SpanID)
+		}
+		key := spanKey{span.TraceID, span.SpanID}
+		if prev, ok := seen[key]; ok {
+			return nil, fmt.Errorf("span %d has the same span id %q as span %d", i, span.SpanID, prev)
+		}
+		seen[key] = i
+	}
+
+	rows := len(b.spans)
+	frame := NewFrame(b.name,
+		NewField("traceID", nil, make([]string, rows)),
+		NewField("spanID", nil, make([]string, rows)),
+		NewField("parentSpanID", nil, make([]string, rows)),
+		NewField("operationName", nil, make([]string, rows)),
+		NewField("serviceName", nil, make([]string, rows)),
+		NewField("serviceTags", nil, make([]json.RawMessage, rows)),
+		NewField("startTime", nil, make([]float64, rows)),
+		NewField("duration", nil, make([]float64, rows)),
+		NewField("logs", nil, make([]json.RawMessage, rows)),
+		NewField("references", nil, make([]json.RawMessage, rows)),
+		NewField("tags", nil, make([]json.RawMessage, rows)),
+		NewField("kind", nil, make([]string, rows)),
+		NewField("statusCode", nil, make([]int64, rows)),
+		NewField("statusMessage", nil, make([]string, rows)),
+	)
+	for i, span := range b.spans {
+		serviceTags, err := json.Marshal(emptyIfNil(span.ServiceTags))
+		if err != nil {
+			return nil, fmt.Errorf("span %d (%s): %w", i, span.SpanID, err)
+		}
+		logs, err := json.Marshal(emptyIfNil(span.Logs))
+		if err != nil {
+			return nil, fmt.Errorf("span %d (%s): %w", i, span.SpanID, err)
+		}
+		references, err := json.Marshal(emptyIfNil(span.References))
+		if err != nil {
+			return nil, fmt.Errorf("span %d (%s): %w", i, span.SpanID, err)
+		}
+		tags, err := json.Marshal(emptyIfNil(span.Tags))
+		if err != nil {
+			return nil, fmt.Errorf("span %d (%s): %w", i, span.SpanID, err)
+		}
+
+		frame.SetRow(i,
+			span.TraceID,
+			span.SpanID,
+			span.ParentSpanID,
+			span.OperationName,
+			span.ServiceName,
+			json.RawMessage(serviceTags),
+			float64(span.StartTime.UnixNano())/float64(time.Millisecond),
+			float64(span.Duration)/float64(time.Millisecond),
+			json.RawMessage(logs),
+			json.RawMessage(references),
+			json.RawMessage(tags),
+			span.Kind,
+			span.StatusCode,
+			span.StatusMessage,
+		)
+	}
+	frame.Meta = &FrameMeta{PreferredVisualization: VisTypeTrace}
+	return frame, nil
+}
+
+// Annotation is a row of an annotations frame built by AnnotationsFrameBuilder.
+type Annotation struct {
+	// Time is the time of the annotation, or the start of a region. It is required.
+	Time time.Time
+
+	// TimeEnd is the end of a region, zero for an annotation of a point in time.
+	TimeEnd time.Time
+
+	Title string
+	Text  string
+	Tags  []string
+}
+
+// AnnotationsFrameBuilder builds a frame of annotations, with DataTopic set to DataTopicAnnotations
+// so panels show it as annotations of the other frames of the response.
+// The data plane contract has no frame type for annotations, so the frame's Meta.Type is not set:
+// panels recognize the frame by its Meta.DataTopic.
+type AnnotationsFrameBuilder struct {
+	name        string
+	annotations []Annotation
+}
+
+// NewAnnotationsFrameBuilder returns an AnnotationsFrameBuilder for a frame with the given name.
+func NewAnnotationsFrameBuilder(name string) *AnnotationsFrameBuilder {
+	return &AnnotationsFrameBuilder{name: name}
+}
+
+// Add adds annotations to the frame.
+func (b *AnnotationsFrameBuilder) Add(annotations ...Annotation) *AnnotationsFrameBuilder {
+	b.annotations = append(b.annotations, annotations...)
+	return b
+}
+
+// Frame returns the annotations frame. Its Fields are "time", "timeEnd", which is null for
+// annotations that are not regions, "title", "text" and "tags".
+// An error is returned if an annotation has no Time, or a TimeEnd before its Time.
+func (b *AnnotationsFrameBuilder) Frame() (*Frame, error) {
+	rows := len(b.annotations)
+	frame := NewFrame(b.name,
+		NewField("time", nil, make([]time.Time, rows)),
+		NewField("timeEnd", nil, make([]*time.Time, rows)),
+		NewField("title", nil, make([]string, rows)),
+		NewField("text", nil, make([]string, rows)),
+		NewField("tags", nil, make([]json.RawMessage, rows)),
+	)
+	for i, a := range b.annotations {
+		if a.Time.IsZero() {
+			return nil, fmt.Errorf("annotation %d has no time", i)
+		}
+		var timeEnd *time.Time
+		if !a.TimeEnd.IsZero() {
+			if a.TimeEnd.Before(a.Time) {
+				return nil, fmt.Errorf("annotation %d ends before it starts", i)
+			}
+			timeEnd = &a.TimeEnd
+		}
+		tags, err := json.Marshal(emptyIfNil(a.Tags))
+		if err != nil {
+			return nil, fmt.Errorf("annotation %d: %w", i, err)
+		}
+		frame.SetRow(i, a.Time, timeEnd, a.Title, a.Text, json.RawMessage(tags))
+	}
+	frame.Meta = &FrameMeta{DataTopic: DataTopicAnnotations}
+	return frame, nil
+}
+
+// emptyIfNil returns an empty slice for a nil slice, so it is marshaled as [] rather than null.
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
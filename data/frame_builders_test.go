@@ -0,0 +1,126 @@
+package data_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestLogsFrameBuilder(t *testing.T) {
+	t0 := time.Unix(1700000000, 0).UTC()
+	frame, err := data.NewLogsFrameBuilder("logs").Add(
+		data.LogLine{Timestamp: t0, Body: "started", Severity: "info", ID: "1", Labels: data.Labels{"app": "api"}},
+		data.LogLine{Timestamp: t0.Add(time.Second), Body: "failed", Severity: "error", ID: "2"},
+	).Frame()
+	require.NoError(t, err)
+
+	expected := data.NewFrame("logs",
+		data.NewField("timestamp", nil, []time.Time{t0, t0.Add(time.Second)}),
+		data.NewField("body", nil, []string{"started", "failed"}),
+		data.NewField("severity", nil, []string{"info", "error"}),
+		data.NewField("id", nil, []string{"1", "2"}),
+		data.NewField("labels", nil, []json.RawMessage{json.RawMessage(`{"app":"api"}`), json.RawMessage(`{}`)}),
+	).SetMeta(&data.FrameMeta{
+		Type:                   data.FrameTypeLogLines,
+		PreferredVisualization: data.VisTypeLogs,
+		UniqueRowIDFields:      []int{3},
+	})
+	if diff := cmp.Diff(expected, frame, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+	require.Empty(t, data.ValidateFrames(data.Frames{frame}))
+
+	// optional fields are only added when they are used
+	frame, err = data.NewLogsFrameBuilder("").Add(data.LogLine{Timestamp: t0, Body: "a"}).Frame()
+	require.NoError(t, err)
+	require.Len(t, frame.Fields, 2)
+	require.Empty(t, data.ValidateFrames(data.Frames{frame}))
+
+	_, err = data.NewLogsFrameBuilder("").Add(data.LogLine{Body: "a"}).Frame()
+	require.EqualError(t, err, "log line 0 has no timestamp")
+	_, err = data.NewLogsFrameBuilder("").Add(data.LogLine{Timestamp: t0, ID: "a"}, data.LogLine{Timestamp: t0}).Frame()
+	require.EqualError(t, err, "log line 1 has no id, but other lines have one")
+	_, err = data.NewLogsFrameBuilder("").Add(data.LogLine{Timestamp: t0, ID: "a"}, data.LogLine{Timestamp: t0, ID: "a"}).Frame()
+	require.EqualError(t, err, `log line 1 has the same id "a" as line 0`)
+}
+
+func TestTraceFrameBuilder(t *testing.T) {
+	t0 := time.Unix(1700000000, 0).UTC()
+	frame, err := data.NewTraceFrameBuilder("trace").Add(
+		data.TraceSpan{
+			TraceID:       "t1",
+			SpanID:        "a",
+			OperationName: "GET /",
+			ServiceName:   "api",
+			ServiceTags:   []data.TraceKeyValue{{Key: "version", Value: "1.0"}},
+			StartTime:     t0,
+			Duration:      1500 * time.Microsecond,
+			Tags:          []data.TraceKeyValue{{Key: "http.status_code", Value: 200}},
+			Logs:          []data.TraceLog{{Timestamp: t0.Add(time.Millisecond), Name: "event", Fields: []data.TraceKeyValue{{Key: "msg", Value: "hi"}}}},
+			Kind:          "server",
+			StatusCode:    1,
+		},
+		data.TraceSpan{
+			TraceID: "t1", SpanID: "b", ParentSpanID: "a", ServiceName: "db",
+			StartTime:  t0.Add(time.Millisecond),
+			References: []data.TraceReference{{TraceID: "t0", SpanID: "x"}},
+		},
+	).Frame()
+	require.NoError(t, err)
+
+	require.Equal(t, data.VisType(data.VisTypeTrace), frame.Meta.PreferredVisualization)
+	require.Equal(t, data.FrameTypeUnknown, frame.Meta.Type)
+	require.Equal(t, 2, frame.Rows())
+	require.Equal(t, []interface{}{
+		"t1", "a", "", "GET /", "api",
+		json.RawMessage(`[{"key":"version","value":"1.0"}]`),
+		1700000000000.0, 1.5,
+		json.RawMessage(`[{"timestamp":1700000000001,"name":"event","fields":[{"key":"msg","value":"hi"}]}]`),
+		json.RawMessage(`[]`),
+		json.RawMessage(`[{"key":"http.status_code","value":200}]`),
+		"server", int64(1), "",
+	}, frame.RowCopy(0))
+	require.Equal(t, json.RawMessage(`[{"traceID":"t0","spanID":"x"}]`), frame.Fields[9].At(1))
+
+	for _, spans := range [][]data.TraceSpan{
+		{{SpanID: "a", StartTime: t0}},
+		{{TraceID: "t", StartTime: t0}},
+		{{TraceID: "t", SpanID: "a"}},
+		{{TraceID: "t", SpanID: "a", StartTime: t0, Duration: -1}},
+		{{TraceID: "t", SpanID: "a", StartTime: t0}, {TraceID: "t", SpanID: "a", StartTime: t0}},
+	} {
+		_, err := data.NewTraceFrameBuilder("").Add(spans...).Frame()
+		require.Error(t, err)
+	}
+}
+
+func TestAnnotationsFrameBuilder(t *testing.T) {
+	t0 := time.Unix(1700000000, 0).UTC()
+	t1 := t0.Add(time.Hour)
+	frame, err := data.NewAnnotationsFrameBuilder("annotations").Add(
+		data.Annotation{Time: t0, Title: "deploy", Text: "v1.2.3", Tags: []string{"deploy", "api"}},
+		data.Annotation{Time: t0, TimeEnd: t1, Title: "outage"},
+	).Frame()
+	require.NoError(t, err)
+
+	expected := data.NewFrame("annotations",
+		data.NewField("time", nil, []time.Time{t0, t0}),
+		data.NewField("timeEnd", nil, []*time.Time{nil, &t1}),
+		data.NewField("title", nil, []string{"deploy", "outage"}),
+		data.NewField("text", nil, []string{"v1.2.3", ""}),
+		data.NewField("tags", nil, []json.RawMessage{json.RawMessage(`["deploy","api"]`), json.RawMessage(`[]`)}),
+	).SetMeta(&data.FrameMeta{DataTopic: data.DataTopicAnnotations})
+	if diff := cmp.Diff(expected, frame, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = data.NewAnnotationsFrameBuilder("").Add(data.Annotation{Title: "x"}).Frame()
+	require.EqualError(t, err, "annotation 0 has no time")
+	_, err = data.NewAnnotationsFrameBuilder("").Add(data.Annotation{Time: t1, TimeEnd: t0}).Frame()
+	require.EqualError(t, err, "annotation 0 ends before it starts")
+}
@@ -0,0 +1,379 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// FrameDiffOptions configure how DiffFrames compares Frames.
+type FrameDiffOptions struct {
+	// FloatTolerance is the largest absolute difference between two float values, or two numeric
+	// values of different types, that are considered equal. Values of the same integer or decimal
+	// type are compared exactly. NaN values are equal to each other, as are infinities of the same sign.
+	FloatTolerance float64
+
+	// TimeTolerance is the largest difference between two time values that are considered equal.
+	TimeTolerance time.Duration
+
+	// IgnoreMeta skips the comparison of the Frames' Meta.
+	IgnoreMeta bool
+
+	// IgnoreConfig skips the comparison of the Fields' Config.
+	IgnoreConfig bool
+
+	// MaxValueDiffs limits the number of value differences kept per Field, 0 means no limit.
+	// The total number of value differences is always counted in FieldDiff.ValueDiffCount.
+	MaxValueDiffs int
+}
+
+// PropertyDiff is a difference of a property of a Frame or Field between the Frames
+// compared by DiffFrames, for example the names of the Frames or the types of a Field.
+type PropertyDiff struct {
+	// Property is the name of the property: "name", "refId", "meta", "fields" or "rows" for
+	// Frames and "name", "type", "labels" or "config" for Fields.
+	Property string
+
+	// A and B are the values of the property in the first and second Frame.
+	A, B interface{}
+}
+
+// ValueDiff is a difference between the values of a Field in the same row.
+type ValueDiff struct {
+	Row int
+
+	// A and B are the concrete values in the first and second Frame, nil if the value is null.
+	A, B interface{}
+}
+
+// FieldDiff holds the differences of a Field that is in both Frames, at the same index.
+type FieldDiff struct {
+	// Index is the index of the Field in the Frames.
+	Index int
+
+	// Name is the name of the Field in the first Frame.
+	Name string
+
+	// Properties are the differences of the name, type, labels and config of the Field.
+	Properties []PropertyDiff
+
+	// Values are the differences of the values of the rows that are in both Frames.
+	// If the types of the Field are not comparable, the values are not compared.
+	Values []ValueDiff
+
+	// ValueDiffCount is the number of value differences, which can be more than
+	// len(Values) if FrameDiffOptions.MaxValueDiffs is set.
+	ValueDiffCount int
+}
+
+// FrameDiff is the structured difference between two Frames created by DiffFrames.
+type FrameDiff struct {
+	// Frame are the differences of the properties of the Frames, including the number of Fields and rows.
+	Frame []PropertyDiff
+
+	// Fields are the differences of the Fields that are in both Frames, in order of their index.
+	// Fields without differences are not included.
+	Fields []FieldDiff
+}
+
+// Empty returns true if there are no differences.
+func (d *FrameDiff) Empty() bool {
+	return d == nil || (len(d.Frame) == 0 && len(d.Fields) == 0)
+}
+
+// String renders the differences in a human readable form, one difference per line,
+// with the value of the first Frame before the value of the second Frame:
+//
+//	rows: 3 != 4
+//	field 1 "value":
+//	  type: float64 != *float64
+//	  row 2: 1.5 != 2
+func (d *FrameDiff) String() string {
+	if d.Empty() {
+		return ""
+	}
+	var sb strings.Builder
+	for _, p := range d.Frame {
+		fmt.Fprintf(&sb, "%s: %s != %s\n", p.Property, formatDiffValue(p.A), formatDiffValue(p.B))
+	}
+	for _, f := range d.Fields {
+		fmt.Fprintf(&sb, "field %d %q:\n", f.Index, f.Name)
+		for _, p := range f.Properties {
+			fmt.Fprintf(&sb, "  %s: %s != %s\n", p.Property, formatDiffValue(p.A), formatDiffValue(p.B))
+		}
+		for _, v := range f.Values {
+			fmt.Fprintf(&sb, "  row %d: %s != %s\n", v.Row, formatDiffValue(v.A), formatDiffValue(v.B))
+		}
+		if more := f.ValueDiffCount - len(f.Values); more > 0 {
+			fmt.Fprintf(&sb, "  ... and %d more value differences\n", more)
+		}
+	}
+	return sb.String()
+}
+
+// DiffFrames compares the Frames a and b and returns their differences.
+// Fields are compared by their index. The values of a Field are compared for the rows that are
+// in both Frames, as concrete values, so a nullable and a non-nullable Field with the same values
+// only differ in type. Numeric values of different numeric types are compared as float64 values.
+func DiffFrames(a, b *Frame, opts FrameDiffOptions) *FrameDiff {
+	d := &FrameDiff{}
+	addProperty := func(property string, x, y interface{}) {
+		d.Frame = append(d.Frame, PropertyDiff{Property: property, A: x, B: y})
+	}
+
+	if a.Name != b.Name {
+		addProperty("name", a.Name, b.Name)
+	}
+	if a.RefID != b.RefID {
+		addProperty("refId", a.RefID, b.RefID)
+	}
+	if !opts.IgnoreMeta && !jsonEqual(a.Meta, b.Meta) {
+		addProperty("meta", a.Meta, b.Meta)
+	}
+	if len(a.Fields) != len(b.Fields) {
+		addProperty("fields", fieldNames(a.Fields), fieldNames(b.Fields))
+	}
+	aRows, bRows := a.Rows(), b.Rows()
+	if aRows != bRows {
+		addProperty("rows", aRows, bRows)
+	}
+
+	rows := aRows
+	if bRows < rows {
+		rows = bRows
+	}
+	for i := 0; i < len(a.Fields) && i < len(b.Fields); i++ {
+		if fd := diffFields(i, a.Fields[i], b.Fields[i], rows, opts); fd != nil {
+			d.Fields = append(d.Fields, *fd)
+		}
+	}
+	return d
+}
+
+// diffFields returns the differences of the Fields x and y in their first rows rows, or nil if there are none.
+func diffFields(idx int, x, y *Field, rows int, opts FrameDiffOptions) *FieldDiff {
+	fd := &FieldDiff{Index: idx, Name: x.Name}
+	addProperty := func(property string, a, b interface{}) {
+		fd.Properties = append(fd.Properties, PropertyDiff{Property: property, A: a, B: b})
+	}
+
+	if x.Name != y.Name {
+		addProperty("name", x.Name, y.Name)
+	}
+	if x.Type() != y.Type() {
+		addProperty("type", x.Type(), y.Type())
+	}
+	if !x.Labels.Equals(y.Labels) {
+		addProperty("labels", x.Labels, y.Labels)
+	}
+	if !opts.IgnoreConfig && !cmp.Equal(x.Config, y.Config, FrameTestCompareOptions()...) {
+		addProperty("config", x.Config, y.Config)
+	}
+
+	if comparableFieldTypes(x.Type(), y.Type()) {
+		for row := 0; row < rows && row < x.Len() && row < y.Len(); row++ {
+			a, aOK := x.ConcreteAt(row)
+			b, bOK := y.ConcreteAt(row)
+			if !aOK {
+				a = nil
+			}
+			if !bOK {
+				b = nil
+			}
+			if diffValuesEqual(a, b, opts) {
+				continue
+			}
+			fd.ValueDiffCount++
+			if opts.MaxValueDiffs <= 0 || len(fd.Values) < opts.MaxValueDiffs {
+				fd.Values = append(fd.Values, ValueDiff{Row: row, A: a, B: b})
+			}
+		}
+	}
+
+	if len(fd.Properties) == 0 && fd.ValueDiffCount == 0 {
+		return nil
+	}
+	return fd
+}
+
+// comparableFieldTypes returns true if the values of Fields of the types x and y can be compared.
+func comparableFieldTypes(x, y FieldType) bool {
+	return x.NonNullableType() == y.NonNullableType() || (x.Numeric() && y.Numeric())
+}
+
+// diffValuesEqual returns true if the concrete values a and b are equal within the tolerances of opts.
+func diffValuesEqual(a, b interface{}, opts FrameDiffOptions) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	switch av := a.(type) {
+	case time.Time:
+		bv, ok := b.(time.Time)
+		if !ok {
+			return false
+		}
+		delta := av.Sub(bv)
+		if delta < 0 {
+			delta = -delta
+		}
+		return delta <= opts.TimeTolerance
+	case json.RawMessage:
+		bv, ok := b.(json.RawMessage)
+		return ok && rawJSONEqual(av, bv)
+	}
+
+	if af, ok := concreteFloat(a); ok {
+		bf, ok := concreteFloat(b)
+		if !ok {
+			return false
+		}
+		sameType := reflect.TypeOf(a) == reflect.TypeOf(b)
+		if !(isConcreteFloat(a) && isConcreteFloat(b)) && (sameType || opts.FloatTolerance == 0) {
+			// integers and decimals lose precision as float64, so they are compared exactly.
+			ar, aOK := concreteRat(a)
+			br, bOK := concreteRat(b)
+			return aOK && bOK && ar.Cmp(br) == 0
+		}
+		switch {
+		case math.IsNaN(af) || math.IsNaN(bf):
+			return math.IsNaN(af) && math.IsNaN(bf)
+		case math.IsInf(af, 0) || math.IsInf(bf, 0):
+			return af == bf
+		}
+		return math.Abs(af-bf) <= opts.FloatTolerance
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// concreteFloat returns the numeric concrete value v, as returned by Field.ConcreteAt, as a float64.
+func concreteFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case Decimal:
+		return n.Float64(), true
+	}
+	return 0, false
+}
+
+// isConcreteFloat returns true if v is a float32 or a float64.
+func isConcreteFloat(v interface{}) bool {
+	switch v.(type) {
+	case float32, float64:
+		return true
+	}
+	return false
+}
+
+// concreteRat returns the numeric concrete value v, as returned by Field.ConcreteAt, as an exact
+// big.Rat. It returns false for NaN and infinite values.
+func concreteRat(v interface{}) (*big.Rat, bool) {
+	switch n := v.(type) {
+	case int8:
+		return new(big.Rat).SetInt64(int64(n)), true
+	case int16:
+		return new(big.Rat).SetInt64(int64(n)), true
+	case int32:
+		return new(big.Rat).SetInt64(int64(n)), true
+	case int64:
+		return new(big.Rat).SetInt64(n), true
+	case uint8:
+		return new(big.Rat).SetUint64(uint64(n)), true
+	case uint16:
+		return new(big.Rat).SetUint64(uint64(n)), true
+	case uint32:
+		return new(big.Rat).SetUint64(uint64(n)), true
+	case uint64:
+		return new(big.Rat).SetUint64(n), true
+	case float32:
+		r := new(big.Rat).SetFloat64(float64(n))
+		return r, r != nil
+	case float64:
+		r := new(big.Rat).SetFloat64(n)
+		return r, r != nil
+	case Decimal:
+		return new(big.Rat).SetFrac(n.Unscaled(), pow10(n.Scale())), true
+	}
+	return nil, false
+}
+
+// jsonEqual returns true if x and y have the same JSON representation, like the comparison
+// of FrameMeta in FrameTestCompareOptions.
+func jsonEqual(x, y interface{}) bool {
+	xJSON, _ := json.Marshal(x)
+	yJSON, _ := json.Marshal(y)
+	return bytes.Equal(xJSON, yJSON)
+}
+
+// rawJSONEqual returns true if x and y are the same JSON regardless of formatting and key order.
+func rawJSONEqual(x, y json.RawMessage) bool {
+	var a, b interface{}
+	if json.Unmarshal(x, &a) != nil || json.Unmarshal(y, &b) != nil {
+		return bytes.Equal(x, y)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func fieldNames(fields Fields) []string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name
+	}
+	return names
+}
+
+// formatDiffValue formats a value of a difference for FrameDiff.String.
+func formatDiffValue(v interface{}) string {
+	switch tv := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return fmt.Sprintf("%q", tv)
+	case time.Time:
+		return tv.Format(time.RFC3339Nano)
+	case json.RawMessage:
+		return string(tv)
+	case []string:
+		return fmt.Sprintf("%q", tv)
+	case FieldType:
+		return tv.ItemTypeString()
+	case Labels:
+		return "{" + tv.String() + "}"
+	case *FrameMeta, *FieldConfig:
+		if reflect.ValueOf(tv).IsNil() {
+			return "null"
+		}
+		b, err := json.Marshal(tv)
+		if err != nil {
+			return fmt.Sprintf("%+v", tv)
+		}
+		return string(b)
+	default:
+		return fmt.Sprintf("%v", tv)
+	}
+}
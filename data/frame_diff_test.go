@@ -0,0 +1,138 @@
+package data_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestDiffFramesEqual(t *testing.T) {
+	a := goldenDF()
+	b, err := a.MarshalArrow()
+	require.NoError(t, err)
+	decoded, err := data.UnmarshalArrowFrame(b)
+	require.NoError(t, err)
+
+	diff := data.DiffFrames(a, decoded, data.FrameDiffOptions{})
+	require.True(t, diff.Empty(), diff.String())
+	require.Equal(t, "", diff.String())
+}
+
+func TestDiffFrames(t *testing.T) {
+	t0 := time.Unix(100, 0).UTC()
+	a := data.NewFrame("a",
+		data.NewField("time", nil, []time.Time{t0, t0.Add(time.Second), t0.Add(2 * time.Second)}),
+		data.NewField("value", data.Labels{"host": "a"}, []float64{1, math.NaN(), 3}),
+		data.NewField("name", nil, []string{"x", "y", "z"}),
+		data.NewField("extra", nil, []int64{1, 2, 3}),
+	).SetMeta(&data.FrameMeta{ExecutedQueryString: "SELECT 1"})
+	b := data.NewFrame("b",
+		data.NewField("time", nil, []time.Time{t0, t0.Add(time.Second + time.Millisecond)}),
+		data.NewField("value", data.Labels{"host": "b"}, []*float64{float64Ptr(1.05), float64Ptr(math.NaN())}).SetConfig(&data.FieldConfig{Unit: "ms"}),
+		data.NewField("name", nil, []int64{1, 2}),
+	)
+
+	diff := data.DiffFrames(a, b, data.FrameDiffOptions{})
+	require.Equal(t, []data.PropertyDiff{
+		{Property: "name", A: "a", B: "b"},
+		{Property: "meta", A: a.Meta, B: (*data.FrameMeta)(nil)},
+		{Property: "fields", A: []string{"time", "value", "name", "extra"}, B: []string{"time", "value", "name"}},
+		{Property: "rows", A: 3, B: 2},
+	}, diff.Frame)
+	require.Equal(t, []data.FieldDiff{
+		{
+			Index: 0, Name: "time",
+			Values:         []data.ValueDiff{{Row: 1, A: t0.Add(time.Second), B: t0.Add(time.Second + time.Millisecond)}},
+			ValueDiffCount: 1,
+		},
+		{
+			Index: 1, Name: "value",
+			Properties: []data.PropertyDiff{
+				{Property: "type", A: data.FieldTypeFloat64, B: data.FieldTypeNullableFloat64},
+				{Property: "labels", A: data.Labels{"host": "a"}, B: data.Labels{"host": "b"}},
+				{Property: "config", A: (*data.FieldConfig)(nil), B: b.Fields[1].Config},
+			},
+			Values:         []data.ValueDiff{{Row: 0, A: 1.0, B: 1.05}},
+			ValueDiffCount: 1,
+		},
+		{
+			Index: 2, Name: "name",
+			Properties: []data.PropertyDiff{{Property: "type", A: data.FieldTypeString, B: data.FieldTypeInt64}},
+		},
+	}, diff.Fields)
+
+	require.Equal(t, `name: "a" != "b"
+meta: {"typeVersion":[0,0],"executedQueryString":"SELECT 1"} != null
+fields: ["time" "value" "name" "extra"] != ["time" "value" "name"]
+rows: 3 != 2
+field 0 "time":
+  row 1: 1970-01-01T00:01:41Z != 1970-01-01T00:01:41.001Z
+field 1 "value":
+  type: float64 != *float64
+  labels: {host=a} != {host=b}
+  config: null != {"unit":"ms"}
+  row 0: 1 != 1.05
+field 2 "name":
+  type: string != int64
+`, diff.String())
+
+	// with tolerances and ignored meta and config only the schema differs
+	diff = data.DiffFrames(a, b, data.FrameDiffOptions{
+		FloatTolerance: 0.1,
+		TimeTolerance:  time.Millisecond,
+		IgnoreMeta:     true,
+		IgnoreConfig:   true,
+	})
+	require.Len(t, diff.Frame, 3)
+	require.Len(t, diff.Fields, 2)
+	require.Empty(t, diff.Fields[0].Values)
+}
+
+func TestDiffFramesMaxValueDiffs(t *testing.T) {
+	a := data.NewFrame("", data.NewField("v", nil, []json.RawMessage{json.RawMessage(`{"a":1,"b":2}`), json.RawMessage(`1`), json.RawMessage(`2`), json.RawMessage(`3`)}))
+	b := data.NewFrame("", data.NewField("v", nil, []*json.RawMessage{pointer(json.RawMessage(`{"b": 2, "a": 1}`)), nil, pointer(json.RawMessage(`4`)), pointer(json.RawMessage(`5`))}))
+
+	diff := data.DiffFrames(a, b, data.FrameDiffOptions{MaxValueDiffs: 1})
+	require.Len(t, diff.Fields, 1)
+	require.Equal(t, 3, diff.Fields[0].ValueDiffCount)
+	require.Equal(t, []data.ValueDiff{{Row: 1, A: json.RawMessage(`1`), B: nil}}, diff.Fields[0].Values)
+	require.Equal(t, `field 0 "v":
+  type: json.RawMessage != *json.RawMessage
+  row 1: 1 != null
+  ... and 2 more value differences
+`, diff.String())
+}
+
+func TestDiffFramesExactIntegers(t *testing.T) {
+	a := data.NewFrame("",
+		data.NewField("int", nil, []int64{9007199254740993}),
+		data.NewField("uint", nil, []uint64{math.MaxUint64}),
+		data.NewField("mixed", nil, []int64{9007199254740993}),
+		data.NewField("decimal", nil, []data.Decimal{data.NewDecimalFromInt64(10, 1)}),
+	)
+	b := data.NewFrame("",
+		data.NewField("int", nil, []int64{9007199254740992}),
+		data.NewField("uint", nil, []uint64{math.MaxUint64 - 1}),
+		data.NewField("mixed", nil, []float64{9007199254740992}),
+		data.NewField("decimal", nil, []data.Decimal{data.NewDecimalFromInt64(1, 0)}),
+	)
+
+	// values of the same type are compared exactly, even with a tolerance
+	diff := data.DiffFrames(a, b, data.FrameDiffOptions{FloatTolerance: 0.5})
+	require.Len(t, diff.Fields, 3)
+	require.Equal(t, 1, diff.Fields[0].ValueDiffCount)
+	require.Equal(t, 1, diff.Fields[1].ValueDiffCount)
+	require.Equal(t, "mixed", diff.Fields[2].Name)
+	require.Equal(t, 0, diff.Fields[2].ValueDiffCount)
+
+	// values of different types are compared exactly without a tolerance
+	diff = data.DiffFrames(a, b, data.FrameDiffOptions{})
+	require.Len(t, diff.Fields, 3)
+	require.Equal(t, "mixed", diff.Fields[2].Name)
+	require.Equal(t, 1, diff.Fields[2].ValueDiffCount)
+}
@@ -241,6 +241,9 @@ func readDataFrameJSON(frame *Frame, iter *jsoniter.Iterator) error {
 		case jsonKeySchema:
 			schema := frameSchema{}
 			iter.ReadVal(&schema)
+			if iter.Error != nil {
+				return iter.Error
+			}
 			frame.Name = schema.Name
 			frame.RefID = schema.RefID
 			frame.Meta = schema.Meta
@@ -312,11 +315,7 @@ func readFrameData(iter *jsoniter.Iterator, frame *Frame) error {
 				if readNanos {
 					if nanos[fieldIndex] != nil {
 						for i := 0; i < size; i++ {
-							t, ok := field.ConcreteAt(i)
-							if !ok {
-								continue
-							}
-							field.Set(i, t.(time.Time).Add(time.Nanosecond*time.Duration(nanos[fieldIndex][i])))
+							addNanosAt(field.vector, i, nanos[fieldIndex][i])
 						}
 					}
 				}
@@ -366,12 +365,7 @@ func readFrameData(iter *jsoniter.Iterator, frame *Frame) error {
 					for idx := 0; iter.ReadArray(); idx++ {
 						ns := iter.ReadInt64()
 						if readValues {
-							t, ok := field.vector.ConcreteAt(idx)
-							if !ok {
-								continue
-							}
-							tWithNS := t.(time.Time).Add(time.Nanosecond * time.Duration(ns))
-							field.vector.SetConcrete(idx, tWithNS)
+							addNanosAt(field.vector, idx, ns)
 							continue
 						}
 						if idx == 0 {
@@ -390,6 +384,20 @@ func readFrameData(iter *jsoniter.Iterator, frame *Frame) error {
 	return nil
 }
 
+// addNanosAt adds the nanosecond offset ns to the time or duration value at idx of the vector.
+func addNanosAt(vec vector, idx int, ns int64) {
+	v, ok := vec.ConcreteAt(idx)
+	if !ok {
+		return
+	}
+	switch v := v.(type) {
+	case time.Time:
+		vec.SetConcrete(idx, v.Add(time.Duration(ns)))
+	case time.Duration:
+		vec.SetConcrete(idx, v+time.Duration(ns))
+	}
+}
+
 func getReplacementValue(key string, ft FieldType) interface{} {
 	v := math.NaN()
 	switch key {
@@ -523,6 +531,21 @@ func jsonValuesToVector(iter *jsoniter.Iterator, ft FieldType) (vector, error) {
 		}
 
 		return vals, nil
+
+	case FieldTypeDecimal, FieldTypeNullableDecimal:
+		// decimals are read from the number text so they keep their precision.
+		vals := NewFieldFromFieldType(ft, 0).vector
+		for idx := 0; iter.ReadArray(); idx++ {
+			vals.Extend(1)
+			d, ok, err := readDecimalJSON(iter)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				vals.SetConcrete(idx, d)
+			}
+		}
+		return vals, nil
 	}
 
 	// if it's not uint64 field, handle the array the old way
@@ -540,6 +563,15 @@ func jsonValuesToVector(iter *jsoniter.Iterator, ft FieldType) (vector, error) {
 			return time.Unix(0, int64(fV)*int64(time.Millisecond)).UTC(), nil
 		}
 
+	case FieldTypeDuration:
+		convert = func(v interface{}) (interface{}, error) {
+			fV, ok := v.(float64)
+			if !ok {
+				return nil, fmt.Errorf("error reading duration")
+			}
+			return time.Duration(int64(fV)) * time.Millisecond, nil
+		}
+
 	case FieldTypeUint8:
 		convert = func(v interface{}) (interface{}, error) {
 			iV, err := int64FromJSON(v)
@@ -692,6 +724,14 @@ func readVector(iter *jsoniter.Iterator, ft FieldType, size int) (vector, error)
 		return readJSONVectorJSON(iter, false, size)
 	case FieldTypeNullableJSON:
 		return readJSONVectorJSON(iter, true, size)
+	case FieldTypeDecimal:
+		return readDecimalVectorJSON(iter, false, size)
+	case FieldTypeNullableDecimal:
+		return readDecimalVectorJSON(iter, true, size)
+	case FieldTypeDuration:
+		return readDurationVectorJSON(iter, false, size)
+	case FieldTypeNullableDuration:
+		return readDurationVectorJSON(iter, true, size)
 
 	// Generated
 	case FieldTypeUint8:
@@ -765,6 +805,8 @@ func getTypeScriptTypeString(t FieldType) (string, bool) {
 		return simpleTypeString, true
 	case FieldTypeEnum, FieldTypeNullableEnum:
 		return simpleTypeEnum, true
+	case FieldTypeDuration, FieldTypeNullableDuration:
+		return simpleTypeNumber, true
 	case FieldTypeJSON, FieldTypeNullableJSON:
 		return simpleTypeOther, true
 	}
@@ -804,6 +846,10 @@ func getFieldTypeForArrow(t arrow.DataType, tsType string) FieldType {
 		return FieldTypeBool
 	case arrow.BINARY:
 		return FieldTypeJSON
+	case arrow.DECIMAL128, arrow.DECIMAL256:
+		return FieldTypeDecimal
+	case arrow.DURATION:
+		return FieldTypeDuration
 	}
 	return FieldTypeUnknown
 }
@@ -987,6 +1033,8 @@ func writeDataFrameData(frame *Frame, stream *jsoniter.Stream) {
 			stream.WriteMore()
 		}
 		isTime := f.Type().Time()
+		isDuration := f.Type().Duration()
+		isDecimal := f.Type().NonNullableType() == FieldTypeDecimal
 		nsTime := make([]int64, rowCount)
 		var hasNSTime bool
 		isFloat := f.Type() == FieldTypeFloat64 || f.Type() == FieldTypeNullableFloat64 ||
@@ -1008,6 +1056,18 @@ func writeDataFrameData(frame *Frame, stream *jsoniter.Stream) {
 						hasNSTime = true
 						nsTime[i] = ns
 					}
+				case isDuration:
+					// durations are written in milliseconds like times, with the remaining
+					// nanoseconds in the same nanos offsets.
+					d := v.(time.Duration)
+					stream.WriteInt64(int64(d / time.Millisecond))
+					if ns := int64(d % time.Millisecond); ns != 0 {
+						hasNSTime = true
+						nsTime[i] = ns
+					}
+				case isDecimal:
+					// decimals are written as exact JSON numbers.
+					stream.WriteRaw(v.(Decimal).String())
 				case isFloat:
 					// For float and nullable float we check whether a value is a special
 					// entity (NaN, -Inf, +Inf) not supported by JSON spec, we then encode this
@@ -1041,6 +1101,7 @@ func writeDataFrameData(frame *Frame, stream *jsoniter.Stream) {
 			} else {
 				stream.WriteNil()
 			}
+			flushJSONStream(stream)
 		}
 		stream.WriteArrayEnd()
 		if hasNSTime {
@@ -1279,6 +1340,16 @@ func writeArrowData(stream *jsoniter.Stream, record arrow.Record) error { //noli
 			ent = writeArrowDataBool(stream, col)
 		case arrow.BINARY:
 			ent = writeArrowDataBinary(stream, col)
+		case arrow.DECIMAL128, arrow.DECIMAL256:
+			if err := writeArrowDataDecimal(stream, col); err != nil {
+				return err
+			}
+		case arrow.DURATION:
+			nanoOffset := writeArrowDataDuration(stream, col)
+			if nanoOffset != nil {
+				nanos[fidx] = nanoOffset
+				hasNano = true
+			}
 		default:
 			return fmt.Errorf("unsupported arrow type %s for JSON", col.DataType().ID())
 		}
@@ -1343,6 +1414,55 @@ func writeArrowDataTIMESTAMP(stream *jsoniter.Stream, col arrow.Array) []int64 {
 	return nil
 }
 
+func writeArrowDataDecimal(stream *jsoniter.Stream, col arrow.Array) error {
+	valueAt, err := arrowDecimalAt(col)
+	if err != nil {
+		return err
+	}
+	stream.WriteArrayStart()
+	for i := 0; i < col.Len(); i++ {
+		if i > 0 {
+			stream.WriteRaw(",")
+		}
+		if col.IsNull(i) {
+			stream.WriteNil()
+			continue
+		}
+		stream.WriteRaw(valueAt(i).String())
+	}
+	stream.WriteArrayEnd()
+	return nil
+}
+
+func writeArrowDataDuration(stream *jsoniter.Stream, col arrow.Array) []int64 {
+	count := col.Len()
+	var hasNSTime bool
+	nsTime := make([]int64, count)
+	v := array.NewDurationData(col.Data())
+	unit := v.DataType().(*arrow.DurationType).Unit.Multiplier()
+	stream.WriteArrayStart()
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			stream.WriteRaw(",")
+		}
+		if col.IsNull(i) {
+			stream.WriteNil()
+			continue
+		}
+		d := time.Duration(v.Value(i)) * unit
+		stream.WriteInt64(int64(d / time.Millisecond))
+		if ns := int64(d % time.Millisecond); ns != 0 {
+			hasNSTime = true
+			nsTime[i] = ns
+		}
+	}
+	stream.WriteArrayEnd()
+	if hasNSTime {
+		return nsTime
+	}
+	return nil
+}
+
 func readTimeVectorJSON(iter *jsoniter.Iterator, nullable bool, size int) (vector, error) {
 	var arr vector
 	if nullable {
@@ -1405,3 +1525,80 @@ func readJSONVectorJSON(iter *jsoniter.Iterator, nullable bool, size int) (vecto
 	}
 	return arr, nil
 }
+
+func readDecimalVectorJSON(iter *jsoniter.Iterator, nullable bool, size int) (vector, error) {
+	var arr vector
+	if nullable {
+		arr = newNullableDecimalVector(size)
+	} else {
+		arr = newDecimalVector(size)
+	}
+
+	for i := 0; i < size; i++ {
+		if !iter.ReadArray() {
+			iter.ReportError("readDecimalVectorJSON", "expected array")
+			return nil, iter.Error
+		}
+
+		d, ok, err := readDecimalJSON(iter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			arr.SetConcrete(i, d)
+		}
+	}
+
+	if iter.ReadArray() {
+		iter.ReportError("read", "expected close array")
+		return nil, iter.Error
+	}
+	return arr, nil
+}
+
+// readDecimalJSON reads a decimal written as a JSON number or string. ok is false if the value is null.
+func readDecimalJSON(iter *jsoniter.Iterator) (d Decimal, ok bool, err error) {
+	switch iter.WhatIsNext() {
+	case sdkjsoniter.NilValue:
+		iter.ReadNil()
+		return d, false, nil
+	case sdkjsoniter.StringValue:
+		d, err = ParseDecimal(iter.ReadString())
+	default:
+		d, err = ParseDecimal(string(iter.ReadNumber()))
+	}
+	if err != nil {
+		return d, false, err
+	}
+	return d, true, iter.Error
+}
+
+func readDurationVectorJSON(iter *jsoniter.Iterator, nullable bool, size int) (vector, error) {
+	var arr vector
+	if nullable {
+		arr = newNullableDurationVector(size)
+	} else {
+		arr = newDurationVector(size)
+	}
+
+	for i := 0; i < size; i++ {
+		if !iter.ReadArray() {
+			iter.ReportError("readDurationVectorJSON", "expected array")
+			return nil, iter.Error
+		}
+
+		t := iter.WhatIsNext()
+		if t == sdkjsoniter.NilValue {
+			iter.ReadNil()
+		} else {
+			ms := iter.ReadInt64()
+			arr.SetConcrete(i, time.Duration(ms)*time.Millisecond)
+		}
+	}
+
+	if iter.ReadArray() {
+		iter.ReportError("read", "expected close array")
+		return nil, iter.Error
+	}
+	return arr, nil
+}
@@ -0,0 +1,187 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// jsonStreamFlushSize is the number of buffered bytes after which frame JSON written to an
+// io.Writer is flushed, so a frame is never fully buffered in memory.
+const jsonStreamFlushSize = 64 * 1024
+
+// flushJSONStream writes the buffered bytes of stream to its writer once they exceed
+// jsonStreamFlushSize. It does nothing for streams without a writer.
+func flushJSONStream(stream *jsoniter.Stream) {
+	if stream.Buffered() > jsonStreamFlushSize {
+		_ = stream.Flush() // the error is kept in stream.Error
+	}
+}
+
+// FrameEncoder writes frames as JSON to an io.Writer, in the same format as json.Marshal
+// and FrameToJSON. Unlike them, the JSON is written to the writer while it is created,
+// the schema first and then the values of the data column by column, so large frames are
+// not buffered in memory.
+type FrameEncoder struct {
+	stream  *jsoniter.Stream
+	include FrameInclude
+}
+
+// NewFrameEncoder returns a FrameEncoder that writes the parts of frames selected by include to w.
+func NewFrameEncoder(w io.Writer, include FrameInclude) *FrameEncoder {
+	return &FrameEncoder{
+		stream:  jsoniter.NewStream(jsoniter.ConfigCompatibleWithStandardLibrary, w, 4096),
+		include: include,
+	}
+}
+
+// Encode writes the JSON of frame followed by a newline, so a sequence of frames can be read
+// back with a FrameDecoder.
+// An error is returned if frame is nil. If the writer fails, the error is returned and all
+// later calls return it as well.
+func (e *FrameEncoder) Encode(frame *Frame) error {
+	if frame == nil {
+		return errors.New("can not encode a nil frame")
+	}
+	if _, err := frame.RowLen(); err != nil {
+		return err
+	}
+	e.writeFrame(frame)
+	e.stream.WriteRaw("\n")
+	return e.flush()
+}
+
+// EncodeFrames writes frames as a JSON array followed by a newline, in the format of json.Marshal(frames).
+// An error is returned, and nothing is written, if a frame is nil.
+func (e *FrameEncoder) EncodeFrames(frames Frames) error {
+	for i, frame := range frames {
+		if frame == nil {
+			return fmt.Errorf("can not encode frame %d, it is nil", i)
+		}
+		if _, err := frame.RowLen(); err != nil {
+			return err
+		}
+	}
+	e.stream.WriteArrayStart()
+	for i, frame := range frames {
+		if i > 0 {
+			e.stream.WriteMore()
+		}
+		e.writeFrame(frame)
+	}
+	e.stream.WriteArrayEnd()
+	e.stream.WriteRaw("\n")
+	return e.flush()
+}
+
+func (e *FrameEncoder) writeFrame(frame *Frame) {
+	includeSchema := e.include == IncludeAll || e.include == IncludeSchemaOnly
+	includeData := e.include == IncludeAll || e.include == IncludeDataOnly
+	writeDataFrame(frame, e.stream, includeSchema, includeData)
+}
+
+func (e *FrameEncoder) flush() error {
+	if e.stream.Error != nil {
+		return e.stream.Error
+	}
+	return e.stream.Flush()
+}
+
+// FrameDecoder reads frames from JSON written by json.Marshal, FrameToJSON or a FrameEncoder.
+// The JSON is read from the reader while the frames are decoded, so the whole input is never
+// buffered in memory.
+type FrameDecoder struct {
+	iter    *jsoniter.Iterator
+	started bool
+	inArray bool
+	done    bool
+}
+
+// NewFrameDecoder returns a FrameDecoder that reads from r. The input may be a sequence of frame
+// JSON objects, such as the output of FrameEncoder.Encode, or a JSON array of frames.
+func NewFrameDecoder(r io.Reader) *FrameDecoder {
+	return &FrameDecoder{
+		iter: jsoniter.Parse(jsoniter.ConfigCompatibleWithStandardLibrary, r, 4096),
+	}
+}
+
+// Decode reads the next frame of the input. At the end of the input, io.EOF is returned.
+func (d *FrameDecoder) Decode() (*Frame, error) {
+	if d.done {
+		return nil, io.EOF
+	}
+	if d.iter.Error != nil {
+		return nil, unexpectedEOF(d.iter.Error)
+	}
+
+	if !d.started {
+		d.started = true
+		d.inArray = d.iter.WhatIsNext() == jsoniter.ArrayValue
+	}
+	if d.inArray {
+		if !d.iter.ReadArray() {
+			if d.iter.Error != nil {
+				return nil, unexpectedEOF(d.iter.Error)
+			}
+			d.done = true
+			return nil, io.EOF
+		}
+	} else if next := d.iter.WhatIsNext(); next != jsoniter.ObjectValue {
+		if errors.Is(d.iter.Error, io.EOF) {
+			d.done = true
+			return nil, io.EOF
+		}
+		if d.iter.Error != nil {
+			return nil, d.iter.Error
+		}
+		return nil, fmt.Errorf("expected a frame JSON object, got a JSON %s", jsonValueTypeString(next))
+	}
+
+	frame := &Frame{}
+	if err := readDataFrameJSON(frame, d.iter); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return frame, nil
+}
+
+// DecodeFrames reads all remaining frames of the input.
+func (d *FrameDecoder) DecodeFrames() (Frames, error) {
+	var frames Frames
+	for {
+		frame, err := d.Decode()
+		if errors.Is(err, io.EOF) {
+			return frames, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		frames = append(frames, frame)
+	}
+}
+
+// unexpectedEOF returns io.ErrUnexpectedEOF if the input ended in the middle of a frame, and err otherwise.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func jsonValueTypeString(t jsoniter.ValueType) string {
+	switch t {
+	case jsoniter.StringValue:
+		return "string"
+	case jsoniter.NumberValue:
+		return "number"
+	case jsoniter.NilValue:
+		return "null"
+	case jsoniter.BoolValue:
+		return "boolean"
+	case jsoniter.ArrayValue:
+		return "array"
+	default:
+		return "value"
+	}
+}
@@ -0,0 +1,135 @@
+package data_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// countingWriter counts the calls to Write, and fails once failAfter bytes were written if it is set.
+type countingWriter struct {
+	bytes.Buffer
+	writes    int
+	failAfter int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	if w.failAfter > 0 && w.Len()+len(p) > w.failAfter {
+		return 0, errors.New("write failed")
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestFrameEncoder(t *testing.T) {
+	frame := goldenDF()
+	expected, err := data.FrameToJSON(frame, data.IncludeAll)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	enc := data.NewFrameEncoder(&buf, data.IncludeAll)
+	require.NoError(t, enc.Encode(frame))
+	require.NoError(t, enc.Encode(frame))
+	require.Equal(t, string(expected)+"\n"+string(expected)+"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, data.NewFrameEncoder(&buf, data.IncludeAll).EncodeFrames(data.Frames{frame, frame}))
+	expected, err = json.Marshal(data.Frames{frame, frame})
+	require.NoError(t, err)
+	require.Equal(t, string(expected)+"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, data.NewFrameEncoder(&buf, data.IncludeSchemaOnly).Encode(frame))
+	expected, err = data.FrameToJSON(frame, data.IncludeSchemaOnly)
+	require.NoError(t, err)
+	require.Equal(t, string(expected)+"\n", buf.String())
+
+	// nil frames are errors, and nothing is written
+	buf.Reset()
+	enc = data.NewFrameEncoder(&buf, data.IncludeAll)
+	require.Error(t, enc.Encode(nil))
+	require.Error(t, enc.EncodeFrames(data.Frames{frame, nil}))
+	require.Empty(t, buf.String())
+}
+
+func TestFrameEncoderStreams(t *testing.T) {
+	values := make([]string, 100_000)
+	for i := range values {
+		values[i] = strings.Repeat("x", 10)
+	}
+	frame := data.NewFrame("large", data.NewField("value", nil, values))
+
+	w := &countingWriter{}
+	require.NoError(t, data.NewFrameEncoder(w, data.IncludeAll).Encode(frame))
+	require.Greater(t, w.writes, 10, "the frame should be written in chunks")
+
+	decoded, err := data.NewFrameDecoder(w).Decode()
+	require.NoError(t, err)
+	if diff := cmp.Diff(frame, decoded, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	w = &countingWriter{failAfter: 1000}
+	enc := data.NewFrameEncoder(w, data.IncludeAll)
+	require.Error(t, enc.Encode(frame))
+	require.Error(t, enc.Encode(data.NewFrame("small")))
+
+	err = enc.Encode(data.NewFrame("mismatch",
+		data.NewField("a", nil, []int64{1}),
+		data.NewField("b", nil, []int64{1, 2}),
+	))
+	require.Error(t, err)
+}
+
+func TestFrameDecoder(t *testing.T) {
+	frames := data.Frames{goldenDF(), data.NewFrame("second", data.NewField("value", nil, []*float64{nil, float64Ptr(1)}))}
+
+	var buf bytes.Buffer
+	enc := data.NewFrameEncoder(&buf, data.IncludeAll)
+	for _, frame := range frames {
+		require.NoError(t, enc.Encode(frame))
+	}
+	sequence := buf.String()
+
+	arr, err := json.Marshal(frames)
+	require.NoError(t, err)
+
+	for name, input := range map[string]string{"sequence": sequence, "array": string(arr)} {
+		t.Run(name, func(t *testing.T) {
+			dec := data.NewFrameDecoder(strings.NewReader(input))
+			first, err := dec.Decode()
+			require.NoError(t, err)
+			if diff := cmp.Diff(frames[0], first, data.FrameTestCompareOptions()...); diff != "" {
+				t.Errorf("Result mismatch (-want +got):\n%s", diff)
+			}
+
+			rest, err := dec.DecodeFrames()
+			require.NoError(t, err)
+			if diff := cmp.Diff(frames[1:], rest, data.FrameTestCompareOptions()...); diff != "" {
+				t.Errorf("Result mismatch (-want +got):\n%s", diff)
+			}
+
+			_, err = dec.Decode()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+
+	// truncated input is an error, not the end of the input
+	for _, input := range []string{sequence[:len(sequence)/2], string(arr[:len(arr)-2]), string(arr[:len(arr)-1]), `"frame"`} {
+		_, err = data.NewFrameDecoder(strings.NewReader(input)).DecodeFrames()
+		require.Error(t, err)
+		require.NotErrorIs(t, err, io.EOF)
+	}
+
+	frames, err = data.NewFrameDecoder(strings.NewReader(" \n")).DecodeFrames()
+	require.NoError(t, err)
+	require.Empty(t, frames)
+}
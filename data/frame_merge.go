@@ -0,0 +1,171 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrFieldTypeConflict is returned by Frame.AppendFrame and AppendFrames when a Field has
+// different types in the Frames, ignoring nullability.
+var ErrFieldTypeConflict = errors.New("field type conflict")
+
+// AppendFrames returns a new Frame with the rows of all frames, in order, even if their Fields differ.
+// It is like calling Frame.AppendFrame for each frame on a Frame without Fields.
+// The Name, RefID and Meta of the result are those of the first frame.
+func AppendFrames(frames ...*Frame) (*Frame, error) {
+	if len(frames) == 0 {
+		return nil, errors.New("no frames to append")
+	}
+
+	first := frames[0]
+	out := &Frame{Name: first.Name, RefID: first.RefID}
+	if first.Meta != nil {
+		meta := *first.Meta
+		out.Meta = &meta
+	}
+	for i, frame := range frames {
+		if err := out.AppendFrame(frame); err != nil {
+			return nil, fmt.Errorf("frame %d (%q): %w", i, frame.Name, err)
+		}
+	}
+	return out, nil
+}
+
+// AppendFrame appends the rows of other to f, merging their schemas. This is useful to collect
+// pages of a downstream API whose objects do not always have the same keys into one Frame.
+//
+// Fields are matched by Name and Labels. A Field of other that f does not have is added after the
+// Fields of f, and its cells in the existing rows are null. A Field of f that other does not have
+// has null cells in the appended rows. Non-nullable Fields that get null cells, or that are nullable
+// in other, are converted to their nullable type in place.
+//
+// If a Field has different types in f and other, ignoring nullability, an error wrapping
+// ErrFieldTypeConflict is returned for each such Field and f is not modified.
+// An error is also returned if the Fields of either Frame have different lengths, or if a Frame has
+// more than one Field with the same Name and Labels.
+// other can be f, which appends a copy of its rows.
+func (f *Frame) AppendFrame(other *Frame) error {
+	rows, err := f.RowLen()
+	if err != nil {
+		return err
+	}
+	otherRows, err := other.RowLen()
+	if err != nil {
+		return err
+	}
+	fieldIndices, err := mergeFieldIndices(f)
+	if err != nil {
+		return err
+	}
+	otherIndices, err := mergeFieldIndices(other)
+	if err != nil {
+		return err
+	}
+
+	// check every Field before modifying f
+	var conflicts []error
+	for _, field := range other.Fields {
+		idx, ok := fieldIndices[mergeFieldIdentity(field)]
+		if !ok {
+			continue
+		}
+		ft, otherFT := f.Fields[idx].Type(), field.Type()
+		if ft.NonNullableType() != otherFT.NonNullableType() {
+			conflicts = append(conflicts, fmt.Errorf("%w: field %q%s is %s but the appended field is %s",
+				ErrFieldTypeConflict, field.Name, mergeLabelsString(field.Labels), ft.ItemTypeString(), otherFT.ItemTypeString()))
+		}
+	}
+	if len(conflicts) > 0 {
+		return errors.Join(conflicts...)
+	}
+
+	for _, field := range f.Fields {
+		otherIdx, ok := otherIndices[mergeFieldIdentity(field)]
+		if !ok {
+			if otherRows > 0 {
+				promoteToNullable(field)
+				field.Extend(otherRows)
+			}
+			continue
+		}
+		otherField := other.Fields[otherIdx]
+		if otherField.Nullable() {
+			promoteToNullable(field)
+		}
+		appendConcreteValues(field, otherField)
+	}
+
+	for _, otherField := range other.Fields {
+		if _, ok := fieldIndices[mergeFieldIdentity(otherField)]; ok {
+			continue
+		}
+		ft := otherField.Type()
+		if rows > 0 {
+			ft = ft.NullableType()
+		}
+		field := NewFieldFromFieldType(ft, rows)
+		field.Name = otherField.Name
+		field.Config = otherField.Config
+		if otherField.Labels != nil {
+			field.Labels = otherField.Labels.Copy()
+		}
+		appendConcreteValues(field, otherField)
+		f.Fields = append(f.Fields, field)
+	}
+	return nil
+}
+
+// mergeFieldIndices returns the indices of the Fields of frame by their identity.
+func mergeFieldIndices(frame *Frame) (map[string]int, error) {
+	indices := make(map[string]int, len(frame.Fields))
+	for i, field := range frame.Fields {
+		identity := mergeFieldIdentity(field)
+		if _, ok := indices[identity]; ok {
+			return nil, fmt.Errorf("frame %q has more than one field %q%s", frame.Name, field.Name, mergeLabelsString(field.Labels))
+		}
+		indices[identity] = i
+	}
+	return indices, nil
+}
+
+// mergeFieldIdentity returns a key of the Name and Labels of field.
+func mergeFieldIdentity(field *Field) string {
+	var labels Labels
+	if len(field.Labels) > 0 {
+		labels = field.Labels
+	}
+	b, _ := json.Marshal([]interface{}{field.Name, labels})
+	return string(b)
+}
+
+func mergeLabelsString(labels Labels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	return "{" + labels.String() + "}"
+}
+
+// promoteToNullable converts field to its nullable type in place, keeping its values.
+func promoteToNullable(field *Field) {
+	if field.Nullable() {
+		return
+	}
+	promoted := NewFieldFromFieldType(field.Type().NullableType(), field.Len())
+	for i := 0; i < field.Len(); i++ {
+		promoted.SetConcrete(i, field.At(i))
+	}
+	field.vector = promoted.vector
+}
+
+// appendConcreteValues appends the values of src to dst, which has the same or the nullable type of src.
+// dst and src can be the same Field.
+func appendConcreteValues(dst, src *Field) {
+	offset, n := dst.Len(), src.Len()
+	dst.Extend(n)
+	for i := 0; i < n; i++ {
+		if v, ok := src.ConcreteAt(i); ok {
+			dst.SetConcrete(offset+i, v)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package data_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestAppendFrames(t *testing.T) {
+	t0 := time.Unix(0, 0).UTC()
+	page1 := data.NewFrame("items",
+		data.NewField("time", nil, []time.Time{t0, t0.Add(time.Second)}),
+		data.NewField("name", nil, []string{"a", "b"}),
+		data.NewField("size", nil, []int64{1, 2}),
+	).SetMeta(&data.FrameMeta{ExecutedQueryString: "page 1"})
+	page2 := data.NewFrame("items",
+		data.NewField("time", nil, []time.Time{t0.Add(2 * time.Second)}),
+		data.NewField("owner", nil, []string{"admin"}),
+		data.NewField("name", nil, []*string{nil}),
+	)
+	page3 := data.NewFrame("items",
+		data.NewField("time", nil, []time.Time{t0.Add(3 * time.Second)}),
+		data.NewField("name", nil, []string{"d"}),
+		data.NewField("size", nil, []int64{4}),
+	)
+
+	merged, err := data.AppendFrames(page1, page2, page3)
+	require.NoError(t, err)
+
+	expected := data.NewFrame("items",
+		data.NewField("time", nil, []time.Time{t0, t0.Add(time.Second), t0.Add(2 * time.Second), t0.Add(3 * time.Second)}),
+		data.NewField("name", nil, []*string{pointer("a"), pointer("b"), nil, pointer("d")}),
+		data.NewField("size", nil, []*int64{int64Ptr(1), int64Ptr(2), nil, int64Ptr(4)}),
+		data.NewField("owner", nil, []*string{nil, nil, pointer("admin"), nil}),
+	).SetMeta(&data.FrameMeta{ExecutedQueryString: "page 1"})
+	if diff := cmp.Diff(expected, merged, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	// the input frames are not modified
+	require.Equal(t, data.FieldTypeString, page1.Fields[1].Type())
+	require.Equal(t, 2, page1.Rows())
+
+	// fields that are in every frame and never null keep their type
+	merged, err = data.AppendFrames(page1, page3)
+	require.NoError(t, err)
+	require.Equal(t, []data.FieldType{data.FieldTypeTime, data.FieldTypeString, data.FieldTypeInt64},
+		[]data.FieldType{merged.Fields[0].Type(), merged.Fields[1].Type(), merged.Fields[2].Type()})
+	require.Equal(t, 3, merged.Rows())
+}
+
+func TestFrameAppendFrame(t *testing.T) {
+	frame := data.NewFrame("",
+		data.NewField("value", data.Labels{"host": "a"}, []float64{1}),
+		data.NewField("value", data.Labels{"host": "b"}, []float64{2}),
+	)
+	sizeField := frame.Fields[0]
+
+	err := frame.AppendFrame(data.NewFrame("",
+		data.NewField("value", data.Labels{"host": "b"}, []float64{3}),
+		data.NewField("value", data.Labels{"host": "c"}, []float64{4}),
+	))
+	require.NoError(t, err)
+
+	expected := data.NewFrame("",
+		data.NewField("value", data.Labels{"host": "a"}, []*float64{float64Ptr(1), nil}),
+		data.NewField("value", data.Labels{"host": "b"}, []float64{2, 3}),
+		data.NewField("value", data.Labels{"host": "c"}, []*float64{nil, float64Ptr(4)}),
+	)
+	if diff := cmp.Diff(expected, frame, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+	// fields are promoted in place
+	require.Same(t, sizeField, frame.Fields[0])
+
+	// appending an empty frame without fields does not add null rows
+	require.NoError(t, frame.AppendFrame(data.NewFrame("")))
+	require.Equal(t, 2, frame.Rows())
+
+	// appending a frame to itself appends a copy of its rows
+	require.NoError(t, frame.AppendFrame(frame))
+	expected = data.NewFrame("",
+		data.NewField("value", data.Labels{"host": "a"}, []*float64{float64Ptr(1), nil, float64Ptr(1), nil}),
+		data.NewField("value", data.Labels{"host": "b"}, []float64{2, 3, 2, 3}),
+		data.NewField("value", data.Labels{"host": "c"}, []*float64{nil, float64Ptr(4), nil, float64Ptr(4)}),
+	)
+	if diff := cmp.Diff(expected, frame, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFrameAppendFrameTypeConflicts(t *testing.T) {
+	frame := data.NewFrame("",
+		data.NewField("id", nil, []int64{1}),
+		data.NewField("name", nil, []string{"a"}),
+		data.NewField("ok", nil, []bool{true}),
+	)
+	err := frame.AppendFrame(data.NewFrame("",
+		data.NewField("id", nil, []string{"2"}),
+		data.NewField("name", nil, []*string{nil}),
+		data.NewField("ok", nil, []float64{1}),
+		data.NewField("new", nil, []float64{1}),
+	))
+	require.ErrorIs(t, err, data.ErrFieldTypeConflict)
+	require.Equal(t, `field type conflict: field "id" is int64 but the appended field is string
+field type conflict: field "ok" is bool but the appended field is float64`, err.Error())
+
+	// the frame is not modified
+	require.Len(t, frame.Fields, 3)
+	require.Equal(t, 1, frame.Rows())
+	require.Equal(t, data.FieldTypeString, frame.Fields[1].Type())
+
+	_, err = data.AppendFrames(frame, data.NewFrame("page", data.NewField("id", nil, []float64{1})))
+	require.True(t, errors.Is(err, data.ErrFieldTypeConflict))
+	require.Contains(t, err.Error(), `frame 1 ("page")`)
+
+	err = frame.AppendFrame(data.NewFrame("dup", data.NewField("a", nil, []int64{1}), data.NewField("a", nil, []int64{2})))
+	require.Error(t, err)
+}
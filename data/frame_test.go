@@ -719,6 +719,21 @@ func BenchmarkFrameBufferClear(b *testing.B) {
 	}
 }
 
+func TestFrameCopyWithoutFields(t *testing.T) {
+	frame := data.NewFrame("cpu", data.NewField("value", nil, []float64{1})).
+		SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide})
+	frame.RefID = "A"
+
+	out := frame.CopyWithoutFields()
+	require.Equal(t, "cpu", out.Name)
+	require.Equal(t, "A", out.RefID)
+	require.Empty(t, out.Fields)
+	require.Equal(t, frame.Meta, out.Meta)
+
+	out.Meta.Type = data.FrameTypeTimeSeriesLong
+	require.Equal(t, data.FrameTypeTimeSeriesWide, frame.Meta.Type)
+}
+
 func BenchmarkFrameBufferCopy(b *testing.B) {
 	b.ReportAllocs()
 
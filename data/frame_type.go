@@ -76,6 +76,15 @@ const FrameTypeNumericLong FrameType = "numeric-long"
 // [Log Lines Format in the Data Plane Contract]: https://grafana.github.io/dataplane/contract/logs#loglines
 const FrameTypeLogLines FrameType = "log-lines"
 
+// FrameTypeHeatmapRows is a heatmap with a time Field followed by one numeric Field per bucket, where each
+// row holds the counts of all buckets at that time. It is the "heatmap-rows" type of the frontend's heatmap panel.
+// See NewHeatmapRowsFrame.
+const FrameTypeHeatmapRows FrameType = "heatmap-rows"
+
+// FrameTypeHeatmapCells is a heatmap with one row per cell: its time, its bucket and its count.
+// It is the "heatmap-cells" type of the frontend's heatmap panel. See NewHeatmapCellsFrame.
+const FrameTypeHeatmapCells FrameType = "heatmap-cells"
+
 // Soon?
 // "timeseries-wide-ohlc" -- known fields for open/high/low/close
 // "histogram" -- BucketMin, BucketMax, values...
@@ -94,6 +103,9 @@ func (p FrameType) IsKnownType() bool {
 
 		FrameTypeLogLines,
 
+		FrameTypeHeatmapRows,
+		FrameTypeHeatmapCells,
+
 		FrameTypeNumericWide,
 		FrameTypeNumericLong,
 		FrameTypeNumericMulti:
@@ -112,6 +124,9 @@ func FrameTypes() []FrameType {
 
 		FrameTypeLogLines,
 
+		FrameTypeHeatmapRows,
+		FrameTypeHeatmapCells,
+
 		FrameTypeNumericWide,
 		FrameTypeNumericLong,
 		FrameTypeNumericMulti,
@@ -147,6 +162,11 @@ func (p FrameType) IsLogs() bool {
 	return p == FrameTypeLogLines
 }
 
+// IsHeatmap checks if the FrameType is one of the heatmap types.
+func (p FrameType) IsHeatmap() bool {
+	return p == FrameTypeHeatmapRows || p == FrameTypeHeatmapCells
+}
+
 // Kind returns the FrameTypeKind from the FrameType.
 func (p FrameType) Kind() FrameTypeKind {
 	switch {
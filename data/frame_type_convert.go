@@ -0,0 +1,423 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// dataplaneTypeVersion is the TypeVersion set on frames produced by the conversions in this file.
+// Setting the TypeVersion to greater than [0, 0] (along with Meta.Type being set) indicates that the produced
+// frame follows the dataplane contract (see https://grafana.com/developers/dataplane/).
+var dataplaneTypeVersion = FrameTypeVersion{0, 1}
+
+// ConvertFrames converts frames from the data plane FrameType declared in the Meta.Type of the first frame
+// to the FrameType to. The supported conversions are between the time series types
+// (FrameTypeTimeSeriesWide, FrameTypeTimeSeriesLong and FrameTypeTimeSeriesMulti) and between the numeric types
+// (FrameTypeNumericWide, FrameTypeNumericLong and FrameTypeNumericMulti).
+//
+// Types that hold a single frame (wide and long) require frames to have exactly one Frame.
+// If the frames are already of type to, they are returned as is.
+// Conversions from long formats do not fill missing values (see LongToWide for fill options).
+func ConvertFrames(frames Frames, to FrameType) (Frames, error) {
+	if len(frames) == 0 {
+		return frames, nil
+	}
+	from, _ := frames[0].TypeInfo("")
+	if from == FrameTypeTimeSeriesMany {
+		from = FrameTypeTimeSeriesMulti
+	}
+	if from == to {
+		return frames, nil
+	}
+	if from.Kind() == KindUnknown || from.Kind() != to.Kind() {
+		return nil, fmt.Errorf("can not convert frames of type %q to type %q", from, to)
+	}
+
+	switch from {
+	case FrameTypeTimeSeriesMulti, FrameTypeNumericMulti:
+		wide, err := multiToWide(frames, from)
+		if err != nil {
+			return nil, err
+		}
+		return ConvertFrames(Frames{wide}, to)
+	}
+
+	if len(frames) != 1 {
+		return nil, fmt.Errorf("expected exactly one frame of type %q, got %d", from, len(frames))
+	}
+	// LongToWide and WideToLong set the Type of the Meta of their input on their result
+	frame := withMetaCopy(frames[0])
+
+	var err error
+	switch {
+	case from == FrameTypeTimeSeriesLong:
+		if frame, err = LongToWide(frame, nil); err != nil {
+			return nil, err
+		}
+	case from == FrameTypeNumericLong:
+		if frame, err = NumericLongToWide(frame); err != nil {
+			return nil, err
+		}
+	}
+
+	switch to {
+	case FrameTypeTimeSeriesWide, FrameTypeNumericWide:
+		return Frames{frame}, nil
+	case FrameTypeTimeSeriesLong:
+		frame, err = WideToLong(frame)
+	case FrameTypeNumericLong:
+		frame, err = NumericWideToLong(frame)
+	case FrameTypeTimeSeriesMulti:
+		return WideToMulti(frame)
+	case FrameTypeNumericMulti:
+		return NumericWideToMulti(frame)
+	default:
+		return nil, fmt.Errorf("can not convert frames of type %q to type %q", from, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+	frame.Meta.TypeVersion = dataplaneTypeVersion
+	return Frames{frame}, nil
+}
+
+// WideToMulti converts a wide time series Frame (see TimeSeriesTypeWide) into time series multi Frames,
+// one per value Field. Each Frame has a copy of the time index Field followed by a copy of the value Field,
+// and a copy of wideFrame's Meta with the Type set to FrameTypeTimeSeriesMulti.
+//
+// An error is returned if wideFrame is not a wide time series.
+func WideToMulti(wideFrame *Frame) (Frames, error) {
+	tsSchema := wideFrame.TimeSeriesSchema()
+	if tsSchema.Type != TimeSeriesTypeWide {
+		return nil, fmt.Errorf("can not convert to multi series, expected wide format series input but got %s series", tsSchema.Type)
+	}
+	if _, err := wideFrame.RowLen(); err != nil {
+		return nil, err
+	}
+
+	timeField := wideFrame.Fields[tsSchema.TimeIndex]
+	frames := make(Frames, 0, len(tsSchema.ValueIndices))
+	for _, vIdx := range tsSchema.ValueIndices {
+		frame := NewFrame(wideFrame.Name, timeField.Copy(), wideFrame.Fields[vIdx].Copy())
+		frame.RefID = wideFrame.RefID
+		frame.Meta = typedMetaCopy(wideFrame.Meta, FrameTypeTimeSeriesMulti)
+		frames = append(frames, frame)
+	}
+	return frames, nil
+}
+
+// MultiToWide converts time series multi Frames, each with a time Field and a single value Field, into
+// one wide time series Frame by outer joining them on time (see JoinFrames). Since the series may not
+// share the same timestamps, the value Fields of the result are nullable.
+//
+// An error is returned if a Frame does not have exactly one time Field and one value Field.
+func MultiToWide(frames Frames) (*Frame, error) {
+	return multiToWide(frames, FrameTypeTimeSeriesMulti)
+}
+
+// LongToMulti converts a long time series Frame into time series multi Frames. It is equivalent
+// to LongToWide followed by WideToMulti.
+func LongToMulti(longFrame *Frame, fillMissing *FillMissing) (Frames, error) {
+	wideFrame, err := LongToWide(withMetaCopy(longFrame), fillMissing)
+	if err != nil {
+		return nil, err
+	}
+	return WideToMulti(wideFrame)
+}
+
+// MultiToLong converts time series multi Frames into one long time series Frame. It is equivalent
+// to MultiToWide followed by WideToLong.
+func MultiToLong(frames Frames) (*Frame, error) {
+	wideFrame, err := MultiToWide(frames)
+	if err != nil {
+		return nil, err
+	}
+	longFrame, err := WideToLong(wideFrame)
+	if err != nil {
+		return nil, err
+	}
+	longFrame.Meta.TypeVersion = dataplaneTypeVersion
+	return longFrame, nil
+}
+
+// NumericWideToLong converts a numeric wide Frame (numeric Fields with labels and at most one row) into
+// a numeric long Frame.
+//
+// Each unique set of Labels across the Fields of wideFrame becomes a row. Each unique Field name becomes a
+// numeric Field, and each unique label key becomes a string Field. Numeric Fields are nullable when a set
+// of Labels has no Field with that name. The Fields are sorted: numeric Fields by name, then string Fields by name.
+//
+// An error is returned if wideFrame has a non-numeric Field, more than one row, or two Fields with the same
+// name but different types.
+func NumericWideToLong(wideFrame *Frame) (*Frame, error) {
+	rowLen, err := wideFrame.RowLen()
+	if err != nil {
+		return nil, err
+	}
+	if rowLen > 1 {
+		return nil, fmt.Errorf("can not convert to numeric long, expected at most one row but got %d", rowLen)
+	}
+
+	nameToType := make(map[string]FieldType)
+	labelKeys := make(map[string]struct{})
+	setToFields := make(map[string][]int) // labels set key -> indices of wideFrame Fields
+	setLabels := make(map[string]Labels)
+	for i, field := range wideFrame.Fields {
+		if !field.Type().Numeric() {
+			return nil, fmt.Errorf("can not convert to numeric long, field %q is not numeric", field.Name)
+		}
+		if ft, ok := nameToType[field.Name]; ok && ft != field.Type() {
+			return nil, fmt.Errorf("two fields in input frame may not have the same name but different types, field name %s has type %s but also type %s", field.Name, ft, field.Type())
+		}
+		nameToType[field.Name] = field.Type()
+
+		key, err := labelsTupleKey(field.Labels)
+		if err != nil {
+			return nil, err
+		}
+		setToFields[key] = append(setToFields[key], i)
+		setLabels[key] = field.Labels
+		for k := range field.Labels {
+			labelKeys[k] = struct{}{}
+		}
+	}
+
+	valueNames := sortedKeys(nameToType)
+	factorNames := sortedKeys(labelKeys)
+	setKeys := sortedKeys(setToFields)
+
+	longFrame := NewFrame(wideFrame.Name)
+	longFrame.RefID = wideFrame.RefID
+	valueNameToIdx := make(map[string]int, len(valueNames))
+	for _, name := range valueNames {
+		ft := nameToType[name]
+		for _, key := range setKeys {
+			if !fieldsHaveName(wideFrame, setToFields[key], name) {
+				ft = ft.NullableType()
+				break
+			}
+		}
+		field := NewFieldFromFieldType(ft, 0)
+		field.Name = name
+		valueNameToIdx[name] = len(longFrame.Fields)
+		longFrame.Fields = append(longFrame.Fields, field)
+	}
+	for _, name := range factorNames {
+		longFrame.Fields = append(longFrame.Fields, NewField(name, nil, []string{}))
+	}
+
+	if rowLen == 1 {
+		for rowIdx, key := range setKeys {
+			longFrame.Extend(1)
+			for _, wideIdx := range setToFields[key] {
+				wideField := wideFrame.Fields[wideIdx]
+				if v, ok := wideField.ConcreteAt(0); ok {
+					longFrame.SetConcrete(valueNameToIdx[wideField.Name], rowIdx, v)
+				}
+			}
+			for i, name := range factorNames {
+				longFrame.Set(len(valueNames)+i, rowIdx, setLabels[key][name])
+			}
+		}
+	}
+
+	longFrame.Meta = typedMetaCopy(wideFrame.Meta, FrameTypeNumericLong)
+	return longFrame, nil
+}
+
+// NumericLongToWide converts a numeric long Frame (numeric Fields and string or bool dimension Fields) into
+// a numeric wide Frame.
+//
+// For each row of longFrame, the string and bool Fields become Labels (nil values become "") and each numeric
+// Field becomes a Field of the wide Frame with a single value. The Fields of the result are sorted by
+// name and then by labels.
+//
+// An error is returned if longFrame has a Field that is neither numeric, string nor bool, or if two rows
+// have the same dimensions.
+func NumericLongToWide(longFrame *Frame) (*Frame, error) {
+	rowLen, err := longFrame.RowLen()
+	if err != nil {
+		return nil, err
+	}
+
+	var valueIndices, factorIndices []int
+	for i, field := range longFrame.Fields {
+		switch ft := field.Type(); {
+		case ft.Numeric():
+			valueIndices = append(valueIndices, i)
+		case ft.NonNullableType() == FieldTypeString, ft.NonNullableType() == FieldTypeBool:
+			factorIndices = append(factorIndices, i)
+		default:
+			return nil, fmt.Errorf("can not convert to numeric wide, field %q is of type %s but must be numeric, string or bool", field.Name, ft)
+		}
+	}
+
+	wideFrame := NewFrame(longFrame.Name)
+	wideFrame.RefID = longFrame.RefID
+	seen := make(map[string]int)
+	for rowIdx := 0; rowIdx < rowLen; rowIdx++ {
+		labels := make(Labels, len(factorIndices))
+		for _, fIdx := range factorIndices {
+			val, _ := longFrame.ConcreteAt(fIdx, rowIdx)
+			switch v := val.(type) {
+			case string:
+				labels[longFrame.Fields[fIdx].Name] = v
+			case bool:
+				labels[longFrame.Fields[fIdx].Name] = strconv.FormatBool(v)
+			}
+		}
+		key, err := labelsTupleKey(labels)
+		if err != nil {
+			return nil, err
+		}
+		if prev, ok := seen[key]; ok {
+			return nil, fmt.Errorf("can not convert to numeric wide, rows %d and %d have the same dimensions {%s}", prev, rowIdx, labels)
+		}
+		seen[key] = rowIdx
+
+		for _, vIdx := range valueIndices {
+			longField := longFrame.Fields[vIdx]
+			wideField := NewFieldFromFieldType(longField.Type(), 1)
+			wideField.Name = longField.Name
+			wideField.Labels = labels.Copy()
+			wideField.Config = longField.Config
+			wideField.Set(0, longField.CopyAt(rowIdx))
+			wideFrame.Fields = append(wideFrame.Fields, wideField)
+		}
+	}
+
+	sort.SliceStable(wideFrame.Fields, func(i, j int) bool {
+		a, b := wideFrame.Fields[i], wideFrame.Fields[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Labels.String() < b.Labels.String()
+	})
+
+	wideFrame.Meta = typedMetaCopy(longFrame.Meta, FrameTypeNumericWide)
+	return wideFrame, nil
+}
+
+// NumericWideToMulti converts a numeric wide Frame into numeric multi Frames, one per Field.
+//
+// An error is returned if wideFrame has a non-numeric Field or more than one row.
+func NumericWideToMulti(wideFrame *Frame) (Frames, error) {
+	rowLen, err := wideFrame.RowLen()
+	if err != nil {
+		return nil, err
+	}
+	if rowLen > 1 {
+		return nil, fmt.Errorf("can not convert to numeric multi, expected at most one row but got %d", rowLen)
+	}
+
+	frames := make(Frames, 0, len(wideFrame.Fields))
+	for _, field := range wideFrame.Fields {
+		if !field.Type().Numeric() {
+			return nil, fmt.Errorf("can not convert to numeric multi, field %q is not numeric", field.Name)
+		}
+		frame := NewFrame(wideFrame.Name, field.Copy())
+		frame.RefID = wideFrame.RefID
+		frame.Meta = typedMetaCopy(wideFrame.Meta, FrameTypeNumericMulti)
+		frames = append(frames, frame)
+	}
+	return frames, nil
+}
+
+// NumericMultiToWide converts numeric multi Frames, each with a single numeric Field of at most one row,
+// into one numeric wide Frame holding a copy of each Field. If some Frames have a row and others do not,
+// the Fields of the Frames without a row become nullable Fields holding a null value.
+func NumericMultiToWide(frames Frames) (*Frame, error) {
+	return multiToWide(frames, FrameTypeNumericMulti)
+}
+
+func multiToWide(frames Frames, from FrameType) (*Frame, error) {
+	if len(frames) == 0 {
+		return nil, errors.New("can not convert to wide, no frames given")
+	}
+
+	if from == FrameTypeTimeSeriesMulti {
+		for i, frame := range frames {
+			tsSchema := frame.TimeSeriesSchema()
+			if tsSchema.Type != TimeSeriesTypeWide || len(tsSchema.ValueIndices) != 1 {
+				return nil, fmt.Errorf("can not convert to wide, frame %d must have exactly one time field and one value field", i)
+			}
+		}
+		wide, err := JoinFrames(JoinOptions{Type: JoinTypeOuter}, frames...)
+		if err != nil {
+			return nil, err
+		}
+		wide.Meta = typedMetaCopy(frames[0].Meta, FrameTypeTimeSeriesWide)
+		return wide, nil
+	}
+
+	rows := 0
+	for i, frame := range frames {
+		if len(frame.Fields) != 1 || !frame.Fields[0].Type().Numeric() {
+			return nil, fmt.Errorf("can not convert to numeric wide, frame %d must have exactly one numeric field", i)
+		}
+		n := frame.Fields[0].Len()
+		if n > 1 {
+			return nil, fmt.Errorf("can not convert to numeric wide, frame %d has more than one row", i)
+		}
+		if n > rows {
+			rows = n
+		}
+	}
+
+	wide := NewFrame(frames[0].Name)
+	wide.RefID = frames[0].RefID
+	for _, frame := range frames {
+		field := frame.Fields[0].Copy()
+		if field.Len() < rows {
+			nullable := NewFieldFromFieldType(field.Type().NullableType(), rows)
+			nullable.Name, nullable.Labels, nullable.Config = field.Name, field.Labels, field.Config
+			field = nullable
+		}
+		wide.Fields = append(wide.Fields, field)
+	}
+	wide.Meta = typedMetaCopy(frames[0].Meta, FrameTypeNumericWide)
+	return wide, nil
+}
+
+// withMetaCopy returns a shallow copy of frame with a copy of its Meta, so that conversions setting
+// the Meta of their result to the Meta of their input do not change frame.
+func withMetaCopy(frame *Frame) *Frame {
+	out := *frame
+	if frame.Meta != nil {
+		meta := *frame.Meta
+		out.Meta = &meta
+	}
+	return &out
+}
+
+// typedMetaCopy returns a copy of meta (or a new FrameMeta if meta is nil) with the Type set to
+// ft and the TypeVersion set to the data plane version.
+func typedMetaCopy(meta *FrameMeta, ft FrameType) *FrameMeta {
+	out := &FrameMeta{}
+	if meta != nil {
+		*out = *meta
+	}
+	out.Type = ft
+	out.TypeVersion = dataplaneTypeVersion
+	return out
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func fieldsHaveName(frame *Frame, indices []int, name string) bool {
+	for _, idx := range indices {
+		if frame.Fields[idx].Name == name {
+			return true
+		}
+	}
+	return false
+}
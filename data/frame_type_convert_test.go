@@ -0,0 +1,164 @@
+package data_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestWideToMultiAndBack(t *testing.T) {
+	t1, t2 := time.Unix(10, 0), time.Unix(20, 0)
+	wide := data.NewFrame("cpu",
+		data.NewField("time", nil, []time.Time{t1, t2}),
+		data.NewField("cpu", data.Labels{"host": "a"}, []float64{1, 2}),
+		data.NewField("cpu", data.Labels{"host": "b"}, []float64{3, 4}),
+	)
+
+	multi, err := data.WideToMulti(wide)
+	require.NoError(t, err)
+	require.Len(t, multi, 2)
+	for _, f := range multi {
+		require.Equal(t, data.FrameTypeTimeSeriesMulti, f.Meta.Type)
+		require.Equal(t, data.FrameTypeVersion{0, 1}, f.Meta.TypeVersion)
+	}
+	require.Empty(t, data.ValidateFrames(multi))
+
+	back, err := data.MultiToWide(multi)
+	require.NoError(t, err)
+	expected := data.NewFrame("cpu",
+		data.NewField("time", nil, []time.Time{t1, t2}),
+		data.NewField("cpu", data.Labels{"host": "a"}, []*float64{float64Ptr(1), float64Ptr(2)}),
+		data.NewField("cpu", data.Labels{"host": "b"}, []*float64{float64Ptr(3), float64Ptr(4)}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide, TypeVersion: data.FrameTypeVersion{0, 1}})
+	if diff := cmp.Diff(expected, back, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMultiToWideRejectsInvalidFrames(t *testing.T) {
+	_, err := data.MultiToWide(data.Frames{data.NewFrame("",
+		data.NewField("time", nil, []time.Time{time.Unix(1, 0)}),
+		data.NewField("a", nil, []float64{1}),
+		data.NewField("b", nil, []float64{1}),
+	)})
+	require.Error(t, err)
+}
+
+func TestNumericWideToLongAndBack(t *testing.T) {
+	wide := data.NewFrame("",
+		data.NewField("cpu", data.Labels{"host": "a"}, []float64{1}),
+		data.NewField("cpu", data.Labels{"host": "b"}, []float64{2}),
+		data.NewField("mem", data.Labels{"host": "a"}, []int64{3}),
+	)
+
+	long, err := data.NumericWideToLong(wide)
+	require.NoError(t, err)
+	expectedLong := data.NewFrame("",
+		data.NewField("cpu", nil, []float64{1, 2}),
+		data.NewField("mem", nil, []*int64{int64Ptr(3), nil}),
+		data.NewField("host", nil, []string{"a", "b"}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeNumericLong, TypeVersion: data.FrameTypeVersion{0, 1}})
+	if diff := cmp.Diff(expectedLong, long, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	back, err := data.NumericLongToWide(long)
+	require.NoError(t, err)
+	expectedWide := data.NewFrame("",
+		data.NewField("cpu", data.Labels{"host": "a"}, []float64{1}),
+		data.NewField("cpu", data.Labels{"host": "b"}, []float64{2}),
+		data.NewField("mem", data.Labels{"host": "a"}, []*int64{int64Ptr(3)}),
+		data.NewField("mem", data.Labels{"host": "b"}, []*int64{nil}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeNumericWide, TypeVersion: data.FrameTypeVersion{0, 1}})
+	if diff := cmp.Diff(expectedWide, back, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNumericLongToWideDuplicateDimensions(t *testing.T) {
+	_, err := data.NumericLongToWide(data.NewFrame("",
+		data.NewField("cpu", nil, []float64{1, 2}),
+		data.NewField("host", nil, []string{"a", "a"}),
+	))
+	require.Error(t, err)
+}
+
+func TestNumericMultiToWide(t *testing.T) {
+	multi := data.Frames{
+		data.NewFrame("", data.NewField("cpu", data.Labels{"host": "a"}, []float64{1})),
+		data.NewFrame("", data.NewField("cpu", data.Labels{"host": "b"}, []float64{})),
+	}
+	wide, err := data.NumericMultiToWide(multi)
+	require.NoError(t, err)
+	expected := data.NewFrame("",
+		data.NewField("cpu", data.Labels{"host": "a"}, []float64{1}),
+		data.NewField("cpu", data.Labels{"host": "b"}, []*float64{nil}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeNumericWide, TypeVersion: data.FrameTypeVersion{0, 1}})
+	if diff := cmp.Diff(expected, wide, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConvertFrames(t *testing.T) {
+	t1, t2 := time.Unix(10, 0), time.Unix(20, 0)
+	long := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{t1, t1, t2, t2}),
+		data.NewField("cpu", nil, []float64{1, 2, 3, 4}),
+		data.NewField("host", nil, []string{"a", "b", "a", "b"}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesLong})
+
+	multi, err := data.ConvertFrames(data.Frames{long}, data.FrameTypeTimeSeriesMulti)
+	require.NoError(t, err)
+	require.Len(t, multi, 2)
+	require.Equal(t, data.Labels{"host": "a"}, multi[0].Fields[1].Labels)
+	require.Equal(t, data.Labels{"host": "b"}, multi[1].Fields[1].Labels)
+
+	backToLong, err := data.ConvertFrames(multi, data.FrameTypeTimeSeriesLong)
+	require.NoError(t, err)
+	require.Len(t, backToLong, 1)
+	require.Equal(t, data.FrameTypeTimeSeriesLong, backToLong[0].Meta.Type)
+	require.Equal(t, data.FrameTypeVersion{0, 1}, backToLong[0].Meta.TypeVersion)
+	require.Equal(t, 4, backToLong[0].Rows())
+	require.Empty(t, data.ValidateFrames(backToLong))
+
+	_, err = data.ConvertFrames(data.Frames{long}, data.FrameTypeNumericWide)
+	require.Error(t, err)
+}
+
+func TestConvertFramesDoesNotChangeInput(t *testing.T) {
+	t1, t2 := time.Unix(10, 0), time.Unix(20, 0)
+	wide := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{t1, t2}),
+		data.NewField("cpu", data.Labels{"host": "a"}, []float64{1, 2}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide})
+	long := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{t1, t2}),
+		data.NewField("cpu", nil, []float64{1, 2}),
+		data.NewField("host", nil, []string{"a", "a"}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesLong})
+
+	tests := []struct {
+		input *data.Frame
+		to    data.FrameType
+	}{
+		{wide, data.FrameTypeTimeSeriesLong},
+		{long, data.FrameTypeTimeSeriesWide},
+		{long, data.FrameTypeTimeSeriesMulti},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.to), func(t *testing.T) {
+			expected := tt.input.Meta.Type
+			_, err := data.ConvertFrames(data.Frames{tt.input}, tt.to)
+			require.NoError(t, err)
+			require.Equal(t, &data.FrameMeta{Type: expected}, tt.input.Meta)
+		})
+	}
+
+	_, err := data.LongToMulti(long, nil)
+	require.NoError(t, err)
+	require.Equal(t, &data.FrameMeta{Type: data.FrameTypeTimeSeriesLong}, long.Meta)
+}
@@ -0,0 +1,373 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Violation describes how a Frame does not conform to the [data plane contract] of the FrameType
+// declared in its Meta.Type.
+//
+// [data plane contract]: https://grafana.github.io/dataplane/contract/
+type Violation struct {
+	// FrameIndex is the index of the offending Frame within the validated Frames.
+	FrameIndex int `json:"frameIndex"`
+
+	// FieldIndex is the index of the offending Field within the Frame, or -1 if the
+	// violation concerns the Frame as a whole.
+	FieldIndex int `json:"fieldIndex"`
+
+	// Type is the FrameType the Frame was validated against.
+	Type FrameType `json:"type"`
+
+	// Message is a human readable description of the violation.
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	if v.FieldIndex < 0 {
+		return fmt.Sprintf("frame %d (%s): %s", v.FrameIndex, v.Type, v.Message)
+	}
+	return fmt.Sprintf("frame %d (%s) field %d: %s", v.FrameIndex, v.Type, v.FieldIndex, v.Message)
+}
+
+// ValidateFrames checks that frames conform to the data plane contract of the FrameType declared in
+// each Frame's Meta.Type, and returns the violations found. A nil slice means no violations were found.
+//
+// The following types are checked:
+//   - FrameTypeTimeSeriesWide, FrameTypeTimeSeriesLong, FrameTypeTimeSeriesMulti (and the deprecated FrameTypeTimeSeriesMany)
+//   - FrameTypeNumericWide, FrameTypeNumericLong, FrameTypeNumericMulti
+//   - FrameTypeLogLines
+//   - FrameTypeDirectoryListing
+//   - FrameTypeTable
+//
+// Frames without a Meta.Type, or with an unrecognized type, are only checked for having Fields of
+// equal length. A Frame with a type but no Fields is valid, as it is the data plane representation
+// of "no data". Response-level rules are checked as well: all frames of a response must share the same
+// type, and series of multi-frame types must be uniquely identified by their name and labels.
+func ValidateFrames(frames Frames) []Violation {
+	var violations []Violation
+	report := func(frameIdx, fieldIdx int, ft FrameType, format string, a ...interface{}) {
+		violations = append(violations, Violation{
+			FrameIndex: frameIdx,
+			FieldIndex: fieldIdx,
+			Type:       ft,
+			Message:    fmt.Sprintf(format, a...),
+		})
+	}
+
+	var firstType FrameType
+	multiSeries := map[string]int{}
+	for frameIdx, frame := range frames {
+		if frame == nil {
+			report(frameIdx, -1, FrameTypeUnknown, "frame is nil")
+			continue
+		}
+		ft, _ := frame.TypeInfo("")
+
+		if frameIdx == 0 {
+			firstType = ft
+		} else if ft != firstType {
+			report(frameIdx, -1, ft, "frame type %q differs from the type %q of the first frame in the response", ft, firstType)
+		}
+
+		v := frameValidator{frame: frame, frameIdx: frameIdx, ft: ft, report: report}
+		if !v.validateCommon() || len(frame.Fields) == 0 {
+			continue
+		}
+
+		switch ft {
+		case FrameTypeTimeSeriesWide:
+			v.validateTimeSeriesWide()
+		case FrameTypeTimeSeriesMulti, FrameTypeTimeSeriesMany:
+			v.validateTimeSeriesMulti(multiSeries)
+		case FrameTypeTimeSeriesLong:
+			v.validateTimeSeriesLong()
+		case FrameTypeNumericWide:
+			v.validateNumericWide()
+		case FrameTypeNumericMulti:
+			v.validateNumericMulti(multiSeries)
+		case FrameTypeNumericLong:
+			v.validateNumericLong()
+		case FrameTypeLogLines:
+			v.validateLogLines()
+		case FrameTypeDirectoryListing:
+			v.validateDirectoryListing()
+		}
+	}
+	return violations
+}
+
+type frameValidator struct {
+	frame    *Frame
+	frameIdx int
+	ft       FrameType
+	report   func(frameIdx, fieldIdx int, ft FrameType, format string, a ...interface{})
+}
+
+func (v frameValidator) frameViolation(format string, a ...interface{}) {
+	v.report(v.frameIdx, -1, v.ft, format, a...)
+}
+
+func (v frameValidator) fieldViolation(fieldIdx int, format string, a ...interface{}) {
+	v.report(v.frameIdx, fieldIdx, v.ft, format, a...)
+}
+
+// validateCommon checks the rules that apply to every frame. It returns false if the
+// frame is too malformed for type specific rules to be checked.
+func (v frameValidator) validateCommon() bool {
+	ok := true
+	for i, field := range v.frame.Fields {
+		if field == nil {
+			v.fieldViolation(i, "field is nil")
+			ok = false
+		}
+	}
+	if !ok {
+		return false
+	}
+	if _, err := v.frame.RowLen(); err != nil {
+		v.frameViolation("%s", err)
+		return false
+	}
+	return true
+}
+
+// timeIndex checks that the frame has a time field, that it is first, and that its values are not
+// null and sorted ascending. It returns the index of the time field, or -1 if there is none.
+func (v frameValidator) timeIndex() int {
+	timeIndices := v.frame.TypeIndices(FieldTypeTime, FieldTypeNullableTime)
+	if len(timeIndices) == 0 {
+		v.frameViolation("frame must have a time field")
+		return -1
+	}
+	idx := timeIndices[0]
+	if idx != 0 {
+		v.fieldViolation(idx, "time field should be the first field")
+	}
+	for _, extra := range timeIndices[1:] {
+		v.fieldViolation(extra, "frame must have only one time field")
+	}
+
+	field := v.frame.Fields[idx]
+	var last time.Time
+	for rowIdx := 0; rowIdx < field.Len(); rowIdx++ {
+		val, ok := field.ConcreteAt(rowIdx)
+		if !ok {
+			v.fieldViolation(idx, "time field must not contain null values, row %d is null", rowIdx)
+			return idx
+		}
+		t := val.(time.Time)
+		if rowIdx > 0 && t.Before(last) {
+			v.fieldViolation(idx, "time field must be sorted in ascending order, row %d is before row %d", rowIdx, rowIdx-1)
+			return idx
+		}
+		last = t
+	}
+	return idx
+}
+
+// numericFieldCount reports every field that is neither a time field nor numeric, and returns
+// the number of numeric fields. Time fields are checked by timeIndex and noTimeFields.
+func (v frameValidator) numericFieldCount() int {
+	n := 0
+	for i, field := range v.frame.Fields {
+		if field.Type().Time() {
+			continue
+		}
+		if !field.Type().Numeric() {
+			v.fieldViolation(i, "field %q must be numeric, but is of type %s", field.Name, field.Type())
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// uniqueSeries reports numeric fields whose name and labels are not unique within the frame.
+func (v frameValidator) uniqueSeries() {
+	seen := map[string]int{}
+	for i, field := range v.frame.Fields {
+		if !field.Type().Numeric() {
+			continue
+		}
+		key := seriesIdentity(field)
+		if first, ok := seen[key]; ok {
+			v.fieldViolation(i, "field name and labels must be unique, field %d has the same name %q and labels {%s}", first, field.Name, field.Labels)
+			continue
+		}
+		seen[key] = i
+	}
+}
+
+func (v frameValidator) validateTimeSeriesWide() {
+	if v.timeIndex() == -1 {
+		return
+	}
+	if v.numericFieldCount() == 0 {
+		v.frameViolation("frame must have at least one numeric field")
+	}
+	v.uniqueSeries()
+}
+
+func (v frameValidator) validateTimeSeriesMulti(seen map[string]int) {
+	if v.timeIndex() == -1 {
+		return
+	}
+	if n := v.numericFieldCount(); n != 1 {
+		v.frameViolation("frame must have exactly one numeric field, found %d", n)
+		return
+	}
+	v.uniqueAcrossFrames(seen)
+}
+
+func (v frameValidator) validateTimeSeriesLong() {
+	if v.timeIndex() == -1 {
+		return
+	}
+	v.validateLong()
+}
+
+func (v frameValidator) validateNumericWide() {
+	if v.noTimeFields() {
+		return
+	}
+	if v.numericFieldCount() == 0 {
+		v.frameViolation("frame must have at least one numeric field")
+	}
+	if rows := v.frame.Rows(); rows > 1 {
+		v.frameViolation("frame must have at most one row, found %d", rows)
+	}
+	v.uniqueSeries()
+}
+
+func (v frameValidator) validateNumericMulti(seen map[string]int) {
+	if v.noTimeFields() {
+		return
+	}
+	if n := v.numericFieldCount(); n != 1 {
+		v.frameViolation("frame must have exactly one numeric field, found %d", n)
+		return
+	}
+	if rows := v.frame.Rows(); rows > 1 {
+		v.frameViolation("frame must have at most one row, found %d", rows)
+	}
+	v.uniqueAcrossFrames(seen)
+}
+
+func (v frameValidator) validateNumericLong() {
+	if v.noTimeFields() {
+		return
+	}
+	v.validateLong()
+}
+
+// validateLong checks the rules shared by the long formats: fields other than time fields
+// are numeric (the metrics) or string/bool (the dimensions), and there is at least one metric.
+func (v frameValidator) validateLong() {
+	numeric := 0
+	for i, field := range v.frame.Fields {
+		ft := field.Type()
+		switch {
+		case ft.Time():
+		case ft.Numeric():
+			numeric++
+			if len(field.Labels) > 0 {
+				v.fieldViolation(i, "field %q must not have labels, dimensions are represented by string fields", field.Name)
+			}
+		case ft == FieldTypeString, ft == FieldTypeNullableString, ft == FieldTypeBool, ft == FieldTypeNullableBool:
+		default:
+			v.fieldViolation(i, "field %q must be numeric, string or bool, but is of type %s", field.Name, ft)
+		}
+	}
+	if numeric == 0 {
+		v.frameViolation("frame must have at least one numeric field")
+	}
+}
+
+// noTimeFields reports time fields and returns true if there are any.
+func (v frameValidator) noTimeFields() bool {
+	timeIndices := v.frame.TypeIndices(FieldTypeTime, FieldTypeNullableTime)
+	for _, idx := range timeIndices {
+		v.fieldViolation(idx, "numeric frames must not have time fields")
+	}
+	return len(timeIndices) > 0
+}
+
+// uniqueAcrossFrames reports the numeric field of a multi frame if a previous frame
+// had a numeric field with the same name and labels.
+func (v frameValidator) uniqueAcrossFrames(seen map[string]int) {
+	for i, field := range v.frame.Fields {
+		if !field.Type().Numeric() {
+			continue
+		}
+		key := seriesIdentity(field)
+		if first, ok := seen[key]; ok {
+			v.fieldViolation(i, "field name and labels must be unique across frames, frame %d has the same name %q and labels {%s}", first, field.Name, field.Labels)
+			continue
+		}
+		seen[key] = v.frameIdx
+	}
+}
+
+func (v frameValidator) validateLogLines() {
+	timestamp, timestampIdx := v.frame.FieldByName("timestamp")
+	switch {
+	case timestamp == nil:
+		v.frameViolation(`frame must have a time field named "timestamp"`)
+	case !timestamp.Type().Time():
+		v.fieldViolation(timestampIdx, `field "timestamp" must be a time field, but is of type %s`, timestamp.Type())
+	}
+
+	body, bodyIdx := v.frame.FieldByName("body")
+	switch {
+	case body == nil:
+		v.frameViolation(`frame must have a string field named "body"`)
+	case body.Type().NonNullableType() != FieldTypeString:
+		v.fieldViolation(bodyIdx, `field "body" must be a string field, but is of type %s`, body.Type())
+	}
+
+	for _, name := range []string{"severity", "id"} {
+		if field, idx := v.frame.FieldByName(name); field != nil && field.Type().NonNullableType() != FieldTypeString {
+			v.fieldViolation(idx, "field %q must be a string field, but is of type %s", name, field.Type())
+		}
+	}
+
+	if labels, idx := v.frame.FieldByName("labels"); labels != nil {
+		if !labels.Type().JSON() {
+			v.fieldViolation(idx, `field "labels" must be a JSON field, but is of type %s`, labels.Type())
+			return
+		}
+		for rowIdx := 0; rowIdx < labels.Len(); rowIdx++ {
+			raw, ok := labels.ConcreteAt(rowIdx)
+			if !ok {
+				continue
+			}
+			var obj map[string]interface{}
+			if err := json.Unmarshal(raw.(json.RawMessage), &obj); err != nil {
+				v.fieldViolation(idx, `field "labels" must contain JSON objects, row %d is not an object`, rowIdx)
+				return
+			}
+		}
+	}
+}
+
+func (v frameValidator) validateDirectoryListing() {
+	fields := v.frame.Fields
+	if fields[0].Type().NonNullableType() != FieldTypeString {
+		v.fieldViolation(0, "first field must be a string field holding the item names, but is of type %s", fields[0].Type())
+	}
+	if len(fields) < 2 {
+		v.frameViolation("frame must have a second string field holding the media types")
+		return
+	}
+	if fields[1].Type().NonNullableType() != FieldTypeString {
+		v.fieldViolation(1, "second field must be a string field holding the media types, but is of type %s", fields[1].Type())
+	}
+}
+
+// seriesIdentity returns a key that identifies a series by the name and labels of its value field.
+func seriesIdentity(field *Field) string {
+	return field.Name + "{" + field.Labels.String() + "}"
+}
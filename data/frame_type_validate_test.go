@@ -0,0 +1,165 @@
+package data_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestValidateFrames(t *testing.T) {
+	t1 := time.Unix(10, 0)
+	t2 := time.Unix(20, 0)
+	typed := func(ft data.FrameType, f *data.Frame) *data.Frame {
+		return f.SetMeta(&data.FrameMeta{Type: ft, TypeVersion: data.FrameTypeVersion{0, 1}})
+	}
+
+	type expected struct {
+		frameIdx int
+		fieldIdx int
+	}
+	tests := []struct {
+		name       string
+		frames     data.Frames
+		violations []expected
+	}{
+		{
+			name: "valid wide",
+			frames: data.Frames{typed(data.FrameTypeTimeSeriesWide, data.NewFrame("",
+				data.NewField("time", nil, []time.Time{t1, t2}),
+				data.NewField("cpu", data.Labels{"host": "a"}, []float64{1, 2}),
+				data.NewField("cpu", data.Labels{"host": "b"}, []*int64{nil, int64Ptr(3)}),
+			))},
+		},
+		{
+			name:   "empty typed frame means no data",
+			frames: data.Frames{typed(data.FrameTypeTimeSeriesMulti, data.NewFrame(""))},
+		},
+		{
+			name: "wide with string field, duplicate series and unsorted time",
+			frames: data.Frames{typed(data.FrameTypeTimeSeriesWide, data.NewFrame("",
+				data.NewField("time", nil, []time.Time{t2, t1}),
+				data.NewField("cpu", nil, []float64{1, 2}),
+				data.NewField("cpu", nil, []float64{1, 2}),
+				data.NewField("host", nil, []string{"a", "b"}),
+			))},
+			violations: []expected{{0, 0}, {0, 3}, {0, 2}},
+		},
+		{
+			name: "wide without time field",
+			frames: data.Frames{typed(data.FrameTypeTimeSeriesWide, data.NewFrame("",
+				data.NewField("cpu", nil, []float64{1}),
+			))},
+			violations: []expected{{0, -1}},
+		},
+		{
+			name: "multi with duplicate series across frames",
+			frames: data.Frames{
+				typed(data.FrameTypeTimeSeriesMulti, data.NewFrame("",
+					data.NewField("time", nil, []time.Time{t1}),
+					data.NewField("cpu", data.Labels{"host": "a"}, []float64{1}),
+				)),
+				typed(data.FrameTypeTimeSeriesMulti, data.NewFrame("",
+					data.NewField("time", nil, []time.Time{t1}),
+					data.NewField("cpu", data.Labels{"host": "a"}, []float64{1}),
+				)),
+			},
+			violations: []expected{{1, 1}},
+		},
+		{
+			name: "multi with two value fields and null time",
+			frames: data.Frames{typed(data.FrameTypeTimeSeriesMulti, data.NewFrame("",
+				data.NewField("time", nil, []*time.Time{nil}),
+				data.NewField("a", nil, []float64{1}),
+				data.NewField("b", nil, []float64{1}),
+			))},
+			violations: []expected{{0, 0}, {0, -1}},
+		},
+		{
+			name: "valid long",
+			frames: data.Frames{typed(data.FrameTypeTimeSeriesLong, data.NewFrame("",
+				data.NewField("time", nil, []time.Time{t1, t1}),
+				data.NewField("cpu", nil, []float64{1, 2}),
+				data.NewField("host", nil, []string{"a", "b"}),
+			))},
+		},
+		{
+			name: "long with labels and json field",
+			frames: data.Frames{typed(data.FrameTypeTimeSeriesLong, data.NewFrame("",
+				data.NewField("time", nil, []time.Time{t1}),
+				data.NewField("cpu", data.Labels{"host": "a"}, []float64{1}),
+				data.NewField("extra", nil, []json.RawMessage{json.RawMessage(`{}`)}),
+			))},
+			violations: []expected{{0, 1}, {0, 2}},
+		},
+		{
+			name: "numeric wide with time field",
+			frames: data.Frames{typed(data.FrameTypeNumericWide, data.NewFrame("",
+				data.NewField("time", nil, []time.Time{t1}),
+				data.NewField("cpu", nil, []float64{1}),
+			))},
+			violations: []expected{{0, 0}},
+		},
+		{
+			name: "numeric wide with many rows",
+			frames: data.Frames{typed(data.FrameTypeNumericWide, data.NewFrame("",
+				data.NewField("cpu", nil, []float64{1, 2}),
+			))},
+			violations: []expected{{0, -1}},
+		},
+		{
+			name: "valid numeric long",
+			frames: data.Frames{typed(data.FrameTypeNumericLong, data.NewFrame("",
+				data.NewField("cpu", nil, []float64{1, 2}),
+				data.NewField("host", nil, []string{"a", "b"}),
+			))},
+		},
+		{
+			name: "valid logs",
+			frames: data.Frames{typed(data.FrameTypeLogLines, data.NewFrame("",
+				data.NewField("timestamp", nil, []time.Time{t1}),
+				data.NewField("body", nil, []string{"hello"}),
+				data.NewField("labels", nil, []json.RawMessage{json.RawMessage(`{"a":"b"}`)}),
+			))},
+		},
+		{
+			name: "logs without body and with bad labels",
+			frames: data.Frames{typed(data.FrameTypeLogLines, data.NewFrame("",
+				data.NewField("timestamp", nil, []time.Time{t1}),
+				data.NewField("labels", nil, []json.RawMessage{json.RawMessage(`[]`)}),
+			))},
+			violations: []expected{{0, -1}, {0, 1}},
+		},
+		{
+			name: "directory listing with non string media type",
+			frames: data.Frames{typed(data.FrameTypeDirectoryListing, data.NewFrame("",
+				data.NewField("name", nil, []string{"a"}),
+				data.NewField("media-type", nil, []int64{1}),
+			))},
+			violations: []expected{{0, 1}},
+		},
+		{
+			name: "mixed frame types and unequal lengths",
+			frames: data.Frames{
+				typed(data.FrameTypeTable, data.NewFrame("", data.NewField("a", nil, []int64{1}))),
+				data.NewFrame("", data.NewField("a", nil, []int64{1}), data.NewField("b", nil, []int64{})),
+			},
+			violations: []expected{{1, -1}, {1, -1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := data.ValidateFrames(tt.frames)
+			actual := make([]expected, 0, len(violations))
+			for _, v := range violations {
+				require.NotEmpty(t, v.Message)
+				actual = append(actual, expected{v.FrameIndex, v.FieldIndex})
+			}
+			require.ElementsMatch(t, tt.violations, actual, "%v", violations)
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package framestruct
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+var (
+	timeType   = reflect.TypeOf(time.Time{})
+	labelsType = reflect.TypeOf(data.Labels{})
+)
+
+// decodeKind is how a struct field is decoded from a frame.
+type decodeKind int
+
+const (
+	decodeValue  decodeKind = iota // the value of the Field with the struct field's name
+	decodeLabel                    // the label with the struct field's name
+	decodeLabels                   // the Labels of the decoded Fields
+	decodeMap                      // the values of the Fields with the map's prefix
+)
+
+// fieldDecoder decodes a struct field, found by its index path, from the frame.
+type fieldDecoder struct {
+	index []int
+	kind  decodeKind
+	name  string
+	field *data.Field
+
+	// mapFields are the Fields of a map by key.
+	mapFields map[string]*data.Field
+}
+
+type decoder struct {
+	conv   *converter
+	fields map[string]*data.Field
+
+	decoders []*fieldDecoder
+	claimed  map[string]bool
+	labels   data.Labels
+}
+
+// FromDataFrame decodes the rows of frame into target, which must be a pointer to a slice of
+// structs or of pointers to structs. It is the reverse of ToDataFrame: each row becomes an element
+// of the slice, and each struct field is set from the Field with the name ToDataFrame would give it,
+// following the same `frame:` tags and the parent prefix of nested structs. The slice is replaced.
+//
+// Null values leave non-pointer struct fields at their zero value, and nil pointer struct fields.
+// Numeric values are converted to the numeric type of the struct field. An error is returned if the
+// conversion changes the value, such as a float with a fractional part into an int, or an int that
+// overflows the struct field. Floats may lose precision when converted to a smaller float type.
+// A struct field of type data.Labels receives the Labels of the decoded Fields, and a string
+// struct field without a Field of its name receives the label of its name, if there is one.
+// A map[string]interface{} struct field receives the non-null values of the Fields with its name
+// as prefix, or with the omitparent tag, of the Fields that no other struct field is decoded from.
+// Fields of the frame that are not decoded into the struct are ignored.
+func FromDataFrame(frame *data.Frame, target interface{}) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice { //nolint:govet // inline analyzer false positive on reflect.Ptr alias
+		return errors.New("unsupported type: can only decode into a pointer to a slice of structs")
+	}
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+	structType := elemType
+	if structType.Kind() == reflect.Ptr { //nolint:govet // inline analyzer false positive on reflect.Ptr alias
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct || structType == timeType {
+		return fmt.Errorf("unsupported type: can only decode into structs, not %s", elemType)
+	}
+
+	rows, err := frame.RowLen()
+	if err != nil {
+		return err
+	}
+
+	d := &decoder{
+		conv:    &converter{tags: make([]string, 3)},
+		fields:  make(map[string]*data.Field, len(frame.Fields)),
+		claimed: make(map[string]bool),
+		labels:  data.Labels{},
+	}
+	for _, field := range frame.Fields {
+		if _, exists := d.fields[field.Name]; !exists {
+			d.fields[field.Name] = field
+		}
+	}
+	d.planStruct(structType, nil, "")
+	d.resolve()
+
+	out := reflect.MakeSlice(slice.Type(), rows, rows)
+	for row := 0; row < rows; row++ {
+		elem := out.Index(row)
+		if elemType.Kind() == reflect.Ptr { //nolint:govet // inline analyzer false positive on reflect.Ptr alias
+			elem.Set(reflect.New(structType))
+			elem = elem.Elem()
+		}
+		if err := d.decodeRow(elem, row); err != nil {
+			return err
+		}
+	}
+	slice.Set(out)
+	return nil
+}
+
+// planStruct adds the decoders of the exported fields of the struct type t.
+func (d *decoder) planStruct(t reflect.Type, index []int, prefix string) {
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		tags := structField.Tag.Get(frameTag)
+		if tags == "-" {
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+		name := d.conv.fieldName(structField.Name, tags, prefix)
+		ft := structField.Type
+		switch {
+		case ft == labelsType:
+			d.decoders = append(d.decoders, &fieldDecoder{index: fieldIndex, kind: decodeLabels})
+		case ft.Kind() == reflect.Struct && ft != timeType:
+			d.planStruct(ft, fieldIndex, name)
+		case ft.Kind() == reflect.Map && ft.Key().Kind() == reflect.String:
+			d.conv.parseTags(tags)
+			if d.conv.tags[1] == "omitparent" {
+				name = ""
+			}
+			d.decoders = append(d.decoders, &fieldDecoder{index: fieldIndex, kind: decodeMap, name: name})
+		default:
+			field, ok := d.fields[name]
+			if !ok {
+				if isStringType(ft) {
+					d.decoders = append(d.decoders, &fieldDecoder{index: fieldIndex, kind: decodeLabel, name: name})
+				}
+				continue
+			}
+			d.claimed[name] = true
+			for k, v := range field.Labels {
+				if _, exists := d.labels[k]; !exists {
+					d.labels[k] = v
+				}
+			}
+			d.decoders = append(d.decoders, &fieldDecoder{index: fieldIndex, kind: decodeValue, name: name, field: field})
+		}
+	}
+}
+
+// resolve finds the Fields of the maps once all struct fields are planned.
+func (d *decoder) resolve() {
+	for _, fd := range d.decoders {
+		if fd.kind != decodeMap {
+			continue
+		}
+		fd.mapFields = make(map[string]*data.Field)
+		for name, field := range d.fields {
+			switch {
+			case fd.name == "" && !d.claimed[name]:
+				fd.mapFields[name] = field
+			case fd.name != "" && strings.HasPrefix(name, fd.name+"."):
+				fd.mapFields[strings.TrimPrefix(name, fd.name+".")] = field
+			}
+		}
+	}
+}
+
+func (d *decoder) decodeRow(elem reflect.Value, row int) error {
+	for _, fd := range d.decoders {
+		dst := elem.FieldByIndex(fd.index)
+		switch fd.kind {
+		case decodeValue:
+			v, ok := fd.field.ConcreteAt(row)
+			if err := setValue(dst, v, ok); err != nil {
+				return fmt.Errorf("row %d: field %q: %w", row, fd.name, err)
+			}
+		case decodeLabel:
+			if v, ok := d.labels[fd.name]; ok {
+				if err := setValue(dst, v, true); err != nil {
+					return fmt.Errorf("label %q: %w", fd.name, err)
+				}
+			}
+		case decodeLabels:
+			if len(d.labels) > 0 {
+				dst.Set(reflect.ValueOf(d.labels.Copy()))
+			}
+		case decodeMap:
+			m := reflect.MakeMap(dst.Type())
+			for key, field := range fd.mapFields {
+				v, ok := field.ConcreteAt(row)
+				if !ok {
+					continue
+				}
+				mv := reflect.New(dst.Type().Elem()).Elem()
+				if err := setValue(mv, v, true); err != nil {
+					return fmt.Errorf("row %d: field %q: %w", row, field.Name, err)
+				}
+				m.SetMapIndex(reflect.ValueOf(key), mv)
+			}
+			dst.Set(m)
+		}
+	}
+	return nil
+}
+
+// setValue sets dst to the concrete value v, or to its zero value if ok is false.
+func setValue(dst reflect.Value, v interface{}, ok bool) error {
+	if !ok {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	if dst.Kind() == reflect.Ptr { //nolint:govet // inline analyzer false positive on reflect.Ptr alias
+		p := reflect.New(dst.Type().Elem())
+		if err := setValue(p.Elem(), v, true); err != nil {
+			return err
+		}
+		dst.Set(p)
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Type().AssignableTo(dst.Type()):
+		dst.Set(rv)
+	case numericKind(rv.Kind()) && numericKind(dst.Kind()):
+		converted, err := convertNumber(rv, dst.Type())
+		if err != nil {
+			return err
+		}
+		dst.Set(converted)
+	case rv.Kind() == dst.Kind() && rv.Type().ConvertibleTo(dst.Type()):
+		dst.Set(rv.Convert(dst.Type()))
+	default:
+		return fmt.Errorf("can not decode %s into %s", rv.Type(), dst.Type())
+	}
+	return nil
+}
+
+// convertNumber converts the number rv to the numeric type t. An error is returned if the
+// conversion changes the value, for example if a float has a fractional part or is out of the
+// range of t. Floats may lose precision when converted to a smaller float type.
+func convertNumber(rv reflect.Value, t reflect.Type) (reflect.Value, error) {
+	converted := rv.Convert(t)
+	if floatKind(rv.Kind()) && floatKind(t.Kind()) {
+		if !math.IsInf(rv.Float(), 0) && math.IsInf(converted.Float(), 0) {
+			return reflect.Value{}, fmt.Errorf("can not decode %v into %s, it is out of range", rv, t)
+		}
+		return converted, nil
+	}
+	// a conversion between signed and unsigned integers can wrap around and back, so the sign is compared too.
+	if (floatKind(rv.Kind()) && math.IsNaN(rv.Float())) || negative(converted) != negative(rv) ||
+		converted.Convert(rv.Type()).Interface() != rv.Interface() {
+		return reflect.Value{}, fmt.Errorf("can not decode %v into %s without changing its value", rv, t)
+	}
+	return converted, nil
+}
+
+func negative(v reflect.Value) bool {
+	switch {
+	case v.CanInt():
+		return v.Int() < 0
+	case v.CanFloat():
+		return v.Float() < 0
+	}
+	return false
+}
+
+func floatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+func isStringType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr { //nolint:govet // inline analyzer false positive on reflect.Ptr alias
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.String
+}
+
+func numericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
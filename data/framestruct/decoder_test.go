@@ -0,0 +1,142 @@
+package framestruct_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/framestruct"
+)
+
+type decodedSeries struct {
+	Time   time.Time `frame:"time"`
+	Value  *float64  `frame:"value"`
+	Count  int
+	Host   string `frame:"host"`
+	Labels data.Labels
+	Nested nested3
+	Inline nested2
+	Ignore string `frame:"-"`
+}
+
+func TestFromDataFrame(t *testing.T) {
+	t0 := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	v := 1.5
+	frame := data.NewFrame("series",
+		data.NewField("time", nil, []time.Time{t0, t0.Add(time.Minute)}),
+		data.NewField("value", data.Labels{"host": "a", "env": "prod"}, []*float64{&v, nil}),
+		data.NewField("Count", nil, []*int64{nil, pointer(int64(7))}),
+		data.NewField("Nested.Thing7", nil, []bool{true, false}),
+		data.NewField("Nested.Thing8", nil, []int32{1, 2}),
+		data.NewField("Thing5", nil, []bool{false, true}),
+		data.NewField("Ignore", nil, []string{"x", "y"}),
+		data.NewField("unused", nil, []string{"x", "y"}),
+	)
+
+	var series []decodedSeries
+	require.NoError(t, framestruct.FromDataFrame(frame, &series))
+	require.Equal(t, []decodedSeries{
+		{
+			Time: t0, Value: &v, Host: "a",
+			Labels: data.Labels{"host": "a", "env": "prod"},
+			Nested: nested3{Thing7: true, Thing8: 1},
+		},
+		{
+			Time: t0.Add(time.Minute), Count: 7, Host: "a",
+			Labels: data.Labels{"host": "a", "env": "prod"},
+			Nested: nested3{Thing8: 2},
+			Inline: nested2{Thing5: true},
+		},
+	}, series)
+
+	var pointers []*decodedSeries
+	require.NoError(t, framestruct.FromDataFrame(frame, &pointers))
+	require.Len(t, pointers, 2)
+	require.Equal(t, series[1], *pointers[1])
+}
+
+func TestFromDataFrameRoundTrip(t *testing.T) {
+	tme := time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
+	for _, input := range []interface{}{
+		[]structWithTags{{"foo", "bar", nested3{true, 36}}, {"baz", "qux", nested3{false, 42}}},
+		[]omitParentStruct{{"foo", "bar", nested2{true, 1}, nested3{true, 2}}},
+		[]timePointerStruct{{&tme}, {nil}},
+		[]structWithCol0{{"zed", map[string]interface{}{"a": "x", "b": int64(1)}}, {"zed2", map[string]interface{}{"a": "y"}}},
+		[]structWithMap{{map[string]interface{}{"a": true}}},
+	} {
+		frame, err := framestruct.ToDataFrame("results", input)
+		require.NoError(t, err)
+
+		switch expected := input.(type) {
+		case []structWithTags:
+			var decoded []structWithTags
+			require.NoError(t, framestruct.FromDataFrame(frame, &decoded))
+			require.Equal(t, expected, decoded)
+		case []omitParentStruct:
+			var decoded []omitParentStruct
+			require.NoError(t, framestruct.FromDataFrame(frame, &decoded))
+			require.Equal(t, expected, decoded)
+		case []timePointerStruct:
+			var decoded []timePointerStruct
+			require.NoError(t, framestruct.FromDataFrame(frame, &decoded))
+			require.Equal(t, expected, decoded)
+		case []structWithCol0:
+			var decoded []structWithCol0
+			require.NoError(t, framestruct.FromDataFrame(frame, &decoded))
+			require.Equal(t, expected, decoded)
+		case []structWithMap:
+			var decoded []structWithMap
+			require.NoError(t, framestruct.FromDataFrame(frame, &decoded))
+			require.Equal(t, expected, decoded)
+		}
+	}
+}
+
+func TestFromDataFrameErrors(t *testing.T) {
+	frame := data.NewFrame("", data.NewField("Foo", nil, []int64{1}))
+
+	var structs []pointerStruct
+	err := framestruct.FromDataFrame(frame, &structs)
+	require.EqualError(t, err, `row 0: field "Foo": can not decode int64 into string`)
+
+	require.Error(t, framestruct.FromDataFrame(frame, structs))
+	require.Error(t, framestruct.FromDataFrame(frame, &[]time.Time{}))
+	require.Error(t, framestruct.FromDataFrame(frame, &pointerStruct{}))
+}
+
+func TestFromDataFrameNumberConversions(t *testing.T) {
+	type ints struct {
+		Foo int8
+	}
+	type floats struct {
+		Foo float32
+	}
+
+	var i []ints
+	require.NoError(t, framestruct.FromDataFrame(data.NewFrame("", data.NewField("Foo", nil, []float64{2, -128})), &i))
+	require.Equal(t, []ints{{2}, {-128}}, i)
+
+	// float to int conversions must not truncate
+	err := framestruct.FromDataFrame(data.NewFrame("", data.NewField("Foo", nil, []float64{1.7})), &i)
+	require.EqualError(t, err, `row 0: field "Foo": can not decode 1.7 into int8 without changing its value`)
+	require.Error(t, framestruct.FromDataFrame(data.NewFrame("", data.NewField("Foo", nil, []float64{math.NaN()})), &i))
+
+	// int conversions must not overflow
+	err = framestruct.FromDataFrame(data.NewFrame("", data.NewField("Foo", nil, []int64{300})), &i)
+	require.EqualError(t, err, `row 0: field "Foo": can not decode 300 into int8 without changing its value`)
+	require.Error(t, framestruct.FromDataFrame(data.NewFrame("", data.NewField("Foo", nil, []uint8{200})), &i))
+	require.Error(t, framestruct.FromDataFrame(data.NewFrame("", data.NewField("Foo", nil, []int64{-1})), &[]struct{ Foo uint64 }{}))
+
+	// floats may lose precision, but not overflow
+	var f []floats
+	require.NoError(t, framestruct.FromDataFrame(data.NewFrame("", data.NewField("Foo", nil, []float64{0.1, math.Inf(1)})), &f))
+	require.Equal(t, []floats{{0.1}, {float32(math.Inf(1))}}, f)
+	require.Error(t, framestruct.FromDataFrame(data.NewFrame("", data.NewField("Foo", nil, []float64{math.MaxFloat64})), &f))
+}
+
+func pointer[T any](v T) *T {
+	return &v
+}
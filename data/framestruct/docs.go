@@ -1,2 +1,3 @@
-// Package framestruct provides functions to convert from any type to *data.Frame or data.Frames
+// Package framestruct provides functions to convert from any type to *data.Frame or data.Frames,
+// and to decode a *data.Frame back into a slice of structs
 package framestruct
@@ -1,60 +1,59 @@
-//nolint:unused
 package data
 
-type nullablegenVector []*gen
+// nullableGenericVector is the vector for nullable Field types, backed by a []*T where nil is a null value.
+type nullableGenericVector[T any] []*T
 
-func newNullablegenVector(n int) *nullablegenVector {
-	v := nullablegenVector(make([]*gen, n))
+func newNullableGenericVector[T any](n int) *nullableGenericVector[T] {
+	v := nullableGenericVector[T](make([]*T, n))
 	return &v
 }
 
-func newNullablegenVectorWithValues(s []*gen) *nullablegenVector {
-	v := make([]*gen, len(s))
+func newNullableGenericVectorWithValues[T any](s []*T) *nullableGenericVector[T] {
+	v := make([]*T, len(s))
 	copy(v, s)
-	return (*nullablegenVector)(&v)
+	return (*nullableGenericVector[T])(&v)
 }
 
-func (v *nullablegenVector) Set(idx int, i interface{}) {
+func (v *nullableGenericVector[T]) Set(idx int, i interface{}) {
 	if i == nil {
 		(*v)[idx] = nil
 		return
 	}
-	(*v)[idx] = i.(*gen)
+	(*v)[idx] = i.(*T)
 }
 
-func (v *nullablegenVector) SetConcrete(idx int, i interface{}) {
-	val := i.(gen)
+func (v *nullableGenericVector[T]) SetConcrete(idx int, i interface{}) {
+	val := i.(T)
 	(*v)[idx] = &val
 }
 
-func (v *nullablegenVector) Append(i interface{}) {
+func (v *nullableGenericVector[T]) Append(i interface{}) {
 	if i == nil {
 		*v = append(*v, nil)
 		return
 	}
-	*v = append(*v, i.(*gen))
+	*v = append(*v, i.(*T))
 }
 
-func (v *nullablegenVector) NilAt(i int) bool {
+func (v *nullableGenericVector[T]) NilAt(i int) bool {
 	return (*v)[i] == nil
 }
 
-func (v *nullablegenVector) At(i int) interface{} {
+func (v *nullableGenericVector[T]) At(i int) interface{} {
 	return (*v)[i]
 }
 
-func (v *nullablegenVector) CopyAt(i int) interface{} {
+func (v *nullableGenericVector[T]) CopyAt(i int) interface{} {
 	if (*v)[i] == nil {
-		var g *gen
+		var g *T
 		return g
 	}
-	var g gen //nolint:staticcheck // S1021: generated code pattern
-	g = *(*v)[i]
+	g := *(*v)[i]
 	return &g
 }
 
-func (v *nullablegenVector) ConcreteAt(i int) (interface{}, bool) {
-	var g gen
+func (v *nullableGenericVector[T]) ConcreteAt(i int) (interface{}, bool) {
+	var g T
 	val := (*v)[i]
 	if val == nil {
 		return g, false
@@ -63,38 +62,38 @@ func (v *nullablegenVector) ConcreteAt(i int) (interface{}, bool) {
 	return g, true
 }
 
-func (v *nullablegenVector) PointerAt(i int) interface{} {
+func (v *nullableGenericVector[T]) PointerAt(i int) interface{} {
 	return &(*v)[i]
 }
 
-func (v *nullablegenVector) Len() int {
+func (v *nullableGenericVector[T]) Len() int {
 	return len(*v)
 }
 
-func (v *nullablegenVector) Cap() int {
+func (v *nullableGenericVector[T]) Cap() int {
 	return cap(*v)
 }
 
-func (v *nullablegenVector) Type() FieldType {
+func (v *nullableGenericVector[T]) Type() FieldType {
 	return vectorFieldType(v)
 }
 
-func (v *nullablegenVector) Extend(i int) {
-	*v = append(*v, make([]*gen, i)...)
+func (v *nullableGenericVector[T]) Extend(i int) {
+	*v = append(*v, make([]*T, i)...)
 }
 
 // Grow reserves capacity for at least n additional elements without changing
 // the vector's length. It is a no-op if the existing capacity already fits.
-func (v *nullablegenVector) Grow(n int) {
+func (v *nullableGenericVector[T]) Grow(n int) {
 	if n <= 0 || cap(*v)-len(*v) >= n {
 		return
 	}
-	grown := make([]*gen, len(*v), len(*v)+n)
+	grown := make([]*T, len(*v), len(*v)+n)
 	copy(grown, *v)
 	*v = grown
 }
 
-func (v *nullablegenVector) Insert(i int, val interface{}) {
+func (v *nullableGenericVector[T]) Insert(i int, val interface{}) {
 	switch {
 	case i < v.Len():
 		v.Extend(1)
@@ -107,11 +106,16 @@ func (v *nullablegenVector) Insert(i int, val interface{}) {
 	}
 }
 
-func (v *nullablegenVector) Delete(i int) {
+func (v *nullableGenericVector[T]) Delete(i int) {
 	*v = append((*v)[:i], (*v)[i+1:]...)
 }
 
 // set the length to zero, but keep the same capacity
-func (v *nullablegenVector) Clear() {
+func (v *nullableGenericVector[T]) Clear() {
 	*v = (*v)[:0]
 }
+
+// slicePointer returns the vector as a *[]*T.
+func (v *nullableGenericVector[T]) slicePointer() interface{} {
+	return (*[]*T)(v)
+}
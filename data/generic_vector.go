@@ -1,92 +1,84 @@
-//nolint:unused
 package data
 
-import (
-	"github.com/cheekybits/genny/generic"
-)
+// genericVector is the vector for non-nullable Field types, backed by a []T.
+type genericVector[T any] []T
 
-type gen generic.Type
-
-type genVector []gen
-
-func newgenVector(n int) *genVector {
-	v := genVector(make([]gen, n))
+func newGenericVector[T any](n int) *genericVector[T] {
+	v := genericVector[T](make([]T, n))
 	return &v
 }
 
-func newgenVectorWithValues(s []gen) *genVector {
-	v := make([]gen, len(s))
+func newGenericVectorWithValues[T any](s []T) *genericVector[T] {
+	v := make([]T, len(s))
 	copy(v, s)
-	return (*genVector)(&v)
+	return (*genericVector[T])(&v)
 }
 
-func (v *genVector) Set(idx int, i interface{}) {
-	(*v)[idx] = i.(gen)
+func (v *genericVector[T]) Set(idx int, i interface{}) {
+	(*v)[idx] = i.(T)
 }
 
-func (v *genVector) SetConcrete(idx int, i interface{}) {
+func (v *genericVector[T]) SetConcrete(idx int, i interface{}) {
 	v.Set(idx, i)
 }
 
-func (v *genVector) Append(i interface{}) {
-	*v = append(*v, i.(gen))
+func (v *genericVector[T]) Append(i interface{}) {
+	*v = append(*v, i.(T))
 }
 
-func (v *genVector) NilAt(i int) bool {
+func (v *genericVector[T]) NilAt(_ int) bool {
 	return false
 }
 
-func (v *genVector) At(i int) interface{} {
+func (v *genericVector[T]) At(i int) interface{} {
 	return (*v)[i]
 }
 
-func (v *genVector) PointerAt(i int) interface{} {
+func (v *genericVector[T]) PointerAt(i int) interface{} {
 	return &(*v)[i]
 }
 
-func (v *genVector) Len() int {
+func (v *genericVector[T]) Len() int {
 	return len(*v)
 }
 
-func (v *genVector) Cap() int {
+func (v *genericVector[T]) Cap() int {
 	return cap(*v)
 }
 
-func (v *genVector) CopyAt(i int) interface{} {
-	var g gen //nolint:staticcheck // S1021: generated code pattern
-	g = (*v)[i]
-	return g
+func (v *genericVector[T]) CopyAt(i int) interface{} {
+	return (*v)[i]
 }
 
-func (v *genVector) ConcreteAt(i int) (interface{}, bool) {
+func (v *genericVector[T]) ConcreteAt(i int) (interface{}, bool) {
 	return v.At(i), true
 }
 
-func (v *genVector) Type() FieldType {
+func (v *genericVector[T]) Type() FieldType {
 	return vectorFieldType(v)
 }
 
-func (v *genVector) Extend(i int) {
-	*v = append(*v, make([]gen, i)...)
+func (v *genericVector[T]) Extend(i int) {
+	*v = append(*v, make([]T, i)...)
 }
 
 // Grow reserves capacity for at least n additional elements without changing
 // the vector's length. It is a no-op if the existing capacity already fits.
-func (v *genVector) Grow(n int) {
+func (v *genericVector[T]) Grow(n int) {
 	if n <= 0 || cap(*v)-len(*v) >= n {
 		return
 	}
-	grown := make([]gen, len(*v), len(*v)+n)
+	grown := make([]T, len(*v), len(*v)+n)
 	copy(grown, *v)
 	*v = grown
 }
 
 // set the length to zero, but keep the same capacity
-func (v *genVector) Clear() {
+func (v *genericVector[T]) Clear() {
 	*v = (*v)[:0]
 }
 
-func (v *genVector) Insert(i int, val interface{}) {
+func (v *genericVector[T]) Insert(i int, val interface{}) {
 	switch {
 	case i < v.Len():
 		v.Extend(1)
@@ -99,6 +91,11 @@ func (v *genVector) Insert(i int, val interface{}) {
 	}
 }
 
-func (v *genVector) Delete(i int) {
+func (v *genericVector[T]) Delete(i int) {
 	*v = append((*v)[:i], (*v)[i+1:]...)
 }
+
+// slicePointer returns the vector as a *[]T.
+func (v *genericVector[T]) slicePointer() interface{} {
+	return (*[]T)(v)
+}
@@ -0,0 +1,414 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// HeatmapLayout is how the buckets of a heatmap relate to their bound.
+type HeatmapLayout string
+
+const (
+	// HeatmapLayoutLe is for buckets whose bound is their upper bound, like Prometheus histogram
+	// buckets with the "le" (less or equal) label.
+	HeatmapLayoutLe HeatmapLayout = "le"
+
+	// HeatmapLayoutGe is for buckets whose bound is their lower bound.
+	HeatmapLayoutGe HeatmapLayout = "ge"
+
+	// HeatmapLayoutUnknown is for buckets whose bound is neither, such as their center or a name.
+	HeatmapLayoutUnknown HeatmapLayout = "unknown"
+)
+
+// HeatmapBucketLabel is the label of Prometheus histogram bucket series with the upper bound of the bucket.
+const HeatmapBucketLabel = "le"
+
+// HeatmapMeta is the FrameMeta.Custom of heatmap frames, which describes their buckets
+// like the frontend's heatmap panel expects.
+type HeatmapMeta struct {
+	// YMatchWithLabel is the label of the bucket Fields of a FrameTypeHeatmapRows frame that holds
+	// the bound of the bucket, such as "le".
+	YMatchWithLabel string `json:"yMatchWithLabel,omitempty"`
+
+	// YOrdinalDisplay are the names of the buckets of a FrameTypeHeatmapCells frame, whose y values
+	// are the index of the bucket in YOrdinalDisplay.
+	YOrdinalDisplay []string `json:"yOrdinalDisplay,omitempty"`
+}
+
+// HeatmapBucket is a bucket of a FrameTypeHeatmapRows frame.
+type HeatmapBucket struct {
+	// Bound is the bound of the bucket, such as "0.5" or "+Inf". It is the name of the bucket's Field.
+	Bound string
+
+	// Counts are the counts of the bucket, one per time.
+	Counts []float64
+}
+
+// HeatmapCell is a cell of a FrameTypeHeatmapCells frame.
+type HeatmapCell struct {
+	Time time.Time
+
+	// Bucket is the index of the bucket of the cell.
+	Bucket int
+
+	Count float64
+}
+
+// NewHeatmapRowsFrame returns a FrameTypeHeatmapRows frame with a "time" Field of times followed by
+// a float64 Field for each bucket, in order. With HeatmapLayoutLe, the bucket Fields have the bound
+// as the HeatmapBucketLabel label, which is set as the HeatmapMeta.YMatchWithLabel of the frame.
+// An error is returned if the Counts of a bucket are not one per time.
+func NewHeatmapRowsFrame(name string, times []time.Time, layout HeatmapLayout, buckets ...HeatmapBucket) (*Frame, error) {
+	frame := NewFrame(name, NewField("time", nil, times))
+	for _, bucket := range buckets {
+		if len(bucket.Counts) != len(times) {
+			return nil, fmt.Errorf("bucket %q has %d counts, but there are %d times", bucket.Bound, len(bucket.Counts), len(times))
+		}
+		frame.Fields = append(frame.Fields, NewField(bucket.Bound, heatmapBucketLabels(layout, bucket.Bound), bucket.Counts))
+	}
+	frame.Meta = heatmapRowsMeta(layout)
+	return frame, nil
+}
+
+// NewHeatmapCellsFrame returns a FrameTypeHeatmapCells frame with one row per cell. Its Fields are
+// "xMax" with the time of the cells, the y Field with the index of the bucket of the cells and "count".
+// The y Field is named "yMax" with HeatmapLayoutLe, "yMin" with HeatmapLayoutGe and "y" otherwise.
+// buckets are the names of the buckets, which are set as the HeatmapMeta.YOrdinalDisplay of the frame.
+// An error is returned if the Bucket of a cell is not an index of buckets.
+func NewHeatmapCellsFrame(name string, layout HeatmapLayout, buckets []string, cells ...HeatmapCell) (*Frame, error) {
+	x := make([]time.Time, len(cells))
+	y := make([]float64, len(cells))
+	count := make([]float64, len(cells))
+	for i, cell := range cells {
+		if cell.Bucket < 0 || cell.Bucket >= len(buckets) {
+			return nil, fmt.Errorf("cell %d has bucket %d, but there are %d buckets", i, cell.Bucket, len(buckets))
+		}
+		x[i], y[i], count[i] = cell.Time, float64(cell.Bucket), cell.Count
+	}
+	return heatmapCellsFrame(name, layout, buckets, x, y, count), nil
+}
+
+// HeatmapRowsFromBuckets converts the Prometheus histogram bucket series of a wide time series frame,
+// which are the numeric Fields with the HeatmapBucketLabel label, into a FrameTypeHeatmapRows frame
+// with HeatmapLayoutLe. The buckets are sorted by their bound, and as Prometheus buckets are
+// cumulative, the count of each bucket is its value minus the value of the previous bucket.
+// Null values stay null and count as 0 for the next bucket.
+// An error is returned if frame has no time Field, no bucket series, or more than one series for a bucket.
+func HeatmapRowsFromBuckets(frame *Frame) (*Frame, error) {
+	schema := frame.TimeSeriesSchema()
+	if schema.Type != TimeSeriesTypeWide {
+		return nil, errors.New("frame is not a wide time series frame")
+	}
+
+	type bucket struct {
+		field *Field
+		bound float64
+	}
+	var buckets []bucket
+	seen := make(map[string]bool)
+	for _, idx := range schema.ValueIndices {
+		field := frame.Fields[idx]
+		le, ok := field.Labels[HeatmapBucketLabel]
+		if !ok {
+			continue
+		}
+		bound, err := strconv.ParseFloat(le, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %q has an invalid bucket bound %q: %w", field.Name, le, err)
+		}
+		if seen[le] {
+			return nil, fmt.Errorf("more than one series for the bucket %q", le)
+		}
+		seen[le] = true
+		buckets = append(buckets, bucket{field: field, bound: bound})
+	}
+	if len(buckets) == 0 {
+		return nil, fmt.Errorf("frame has no fields with the %q label", HeatmapBucketLabel)
+	}
+	sort.SliceStable(buckets, func(i, j int) bool { return buckets[i].bound < buckets[j].bound })
+
+	timeField := frame.Fields[schema.TimeIndex]
+	rows := timeField.Len()
+	out := NewFrame(frame.Name, timeField.Copy())
+	out.RefID = frame.RefID
+	previous := make([]float64, rows)
+	for _, b := range buckets {
+		le := b.field.Labels[HeatmapBucketLabel]
+		ft := FieldTypeFloat64
+		if b.field.Nullable() {
+			ft = FieldTypeNullableFloat64
+		}
+		field := NewFieldFromFieldType(ft, rows)
+		field.Name = le
+		field.Labels = heatmapBucketLabels(HeatmapLayoutLe, le)
+		field.Config = b.field.Config
+		for row := 0; row < rows; row++ {
+			v, err := b.field.NullableFloatAt(row)
+			if err != nil {
+				return nil, err
+			}
+			if v == nil {
+				continue
+			}
+			field.SetConcrete(row, *v-previous[row])
+			previous[row] = *v
+		}
+		out.Fields = append(out.Fields, field)
+	}
+	out.Meta = heatmapRowsMeta(HeatmapLayoutLe)
+	return out, nil
+}
+
+// HeatmapRowsToCells converts a FrameTypeHeatmapRows frame into a FrameTypeHeatmapCells frame, with
+// a cell for each non-null count, ordered by time and then bucket. The names of the bucket Fields
+// become the HeatmapMeta.YOrdinalDisplay of the cells frame. If layout is empty, it is
+// HeatmapLayoutLe if the bucket Fields have the HeatmapMeta.YMatchWithLabel or the HeatmapBucketLabel
+// label, and HeatmapLayoutUnknown otherwise.
+func HeatmapRowsToCells(frame *Frame, layout HeatmapLayout) (*Frame, error) {
+	if _, err := frame.RowLen(); err != nil {
+		return nil, err
+	}
+	timeIdx := -1
+	var buckets []*Field
+	for i, field := range frame.Fields {
+		switch {
+		case timeIdx == -1 && field.Type().Time():
+			timeIdx = i
+		case field.Type().Numeric():
+			buckets = append(buckets, field)
+		}
+	}
+	if timeIdx == -1 {
+		return nil, errors.New("frame has no time field")
+	}
+	if len(buckets) == 0 {
+		return nil, errors.New("frame has no bucket fields")
+	}
+
+	label := heatmapMetaOf(frame).YMatchWithLabel
+	if label == "" {
+		label = HeatmapBucketLabel
+	}
+	names := make([]string, len(buckets))
+	for i, field := range buckets {
+		names[i] = field.Name
+		if bound, ok := field.Labels[label]; ok {
+			names[i] = bound
+			if layout == "" {
+				layout = HeatmapLayoutLe
+			}
+		}
+	}
+	if layout == "" {
+		layout = HeatmapLayoutUnknown
+	}
+
+	timeField := frame.Fields[timeIdx]
+	var x []time.Time
+	var y, count []float64
+	for row := 0; row < timeField.Len(); row++ {
+		t, ok := timeField.ConcreteAt(row)
+		if !ok {
+			return nil, ErrorNullTimeValues
+		}
+		for i, field := range buckets {
+			v, err := field.NullableFloatAt(row)
+			if err != nil {
+				return nil, err
+			}
+			if v == nil {
+				continue
+			}
+			x = append(x, t.(time.Time))
+			y = append(y, float64(i))
+			count = append(count, *v)
+		}
+	}
+
+	out := heatmapCellsFrame(frame.Name, layout, names, x, y, count)
+	out.RefID = frame.RefID
+	return out, nil
+}
+
+// HeatmapCellsToRows converts a FrameTypeHeatmapCells frame into a FrameTypeHeatmapRows frame, with a
+// row for each distinct time in ascending order and a nullable float64 bucket Field for each bucket.
+// The y values of the cells are the index of their bucket in the HeatmapMeta.YOrdinalDisplay of the
+// frame, or else the bounds of the buckets. Counts of missing cells are null.
+// The cells frame is expected to have a time Field followed by the y and count Fields, or the
+// Fields "yMax", "yMin" or "y" and "count".
+func HeatmapCellsToRows(frame *Frame) (*Frame, error) {
+	if _, err := frame.RowLen(); err != nil {
+		return nil, err
+	}
+	xField, yField, countField, layout := heatmapCellsFields(frame)
+	if xField == nil || yField == nil || countField == nil {
+		return nil, errors.New("frame must have a time field, a numeric y field and a numeric count field")
+	}
+
+	ordinals := heatmapMetaOf(frame).YOrdinalDisplay
+	bucketIdx := make(map[float64]int)
+	var names []string
+	if len(ordinals) > 0 {
+		names = ordinals
+		for i := range ordinals {
+			bucketIdx[float64(i)] = i
+		}
+	} else {
+		var bounds []float64
+		for row := 0; row < yField.Len(); row++ {
+			v, err := yField.NullableFloatAt(row)
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				if _, ok := bucketIdx[*v]; !ok {
+					bucketIdx[*v] = 0
+					bounds = append(bounds, *v)
+				}
+			}
+		}
+		sort.Float64s(bounds)
+		for i, bound := range bounds {
+			bucketIdx[bound] = i
+			names = append(names, strconv.FormatFloat(bound, 'f', -1, 64))
+		}
+	}
+
+	var times []time.Time
+	timeIdx := make(map[time.Time]int)
+	for row := 0; row < xField.Len(); row++ {
+		t, ok := xField.ConcreteAt(row)
+		if !ok {
+			return nil, ErrorNullTimeValues
+		}
+		if _, ok := timeIdx[t.(time.Time)]; !ok {
+			timeIdx[t.(time.Time)] = 0
+			times = append(times, t.(time.Time))
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	for i, t := range times {
+		timeIdx[t] = i
+	}
+
+	out := NewFrame(frame.Name, NewField("time", nil, times))
+	out.RefID = frame.RefID
+	for _, name := range names {
+		field := NewFieldFromFieldType(FieldTypeNullableFloat64, len(times))
+		field.Name = name
+		field.Labels = heatmapBucketLabels(layout, name)
+		out.Fields = append(out.Fields, field)
+	}
+	for row := 0; row < xField.Len(); row++ {
+		t, _ := xField.ConcreteAt(row)
+		y, err := yField.NullableFloatAt(row)
+		if err != nil {
+			return nil, err
+		}
+		c, err := countField.NullableFloatAt(row)
+		if err != nil {
+			return nil, err
+		}
+		if y == nil || c == nil {
+			continue
+		}
+		idx, ok := bucketIdx[*y]
+		if !ok {
+			return nil, fmt.Errorf("row %d has the y value %v, which is not a bucket", row, *y)
+		}
+		out.Fields[idx+1].SetConcrete(timeIdx[t.(time.Time)], *c)
+	}
+	out.Meta = heatmapRowsMeta(layout)
+	return out, nil
+}
+
+// heatmapCellsFields returns the x, y and count Fields of a heatmap cells frame and the layout of its buckets.
+func heatmapCellsFields(frame *Frame) (x, y, count *Field, layout HeatmapLayout) {
+	var numeric []*Field
+	for _, field := range frame.Fields {
+		switch {
+		case x == nil && field.Type().Time():
+			x = field
+		case field.Type().Numeric():
+			numeric = append(numeric, field)
+			switch field.Name {
+			case "yMax":
+				y, layout = field, HeatmapLayoutLe
+			case "yMin":
+				y, layout = field, HeatmapLayoutGe
+			case "y":
+				y, layout = field, HeatmapLayoutUnknown
+			case "count":
+				count = field
+			}
+		}
+	}
+	if y == nil && len(numeric) > 0 {
+		y, layout = numeric[0], HeatmapLayoutUnknown
+	}
+	if count == nil {
+		for _, field := range numeric {
+			if field != y {
+				count = field
+				break
+			}
+		}
+	}
+	return x, y, count, layout
+}
+
+func heatmapCellsFrame(name string, layout HeatmapLayout, buckets []string, x []time.Time, y, count []float64) *Frame {
+	yName := "y"
+	switch layout {
+	case HeatmapLayoutLe:
+		yName = "yMax"
+	case HeatmapLayoutGe:
+		yName = "yMin"
+	}
+	return NewFrame(name,
+		NewField("xMax", nil, x),
+		NewField(yName, nil, y),
+		NewField("count", nil, count),
+	).SetMeta(&FrameMeta{
+		Type:   FrameTypeHeatmapCells,
+		Custom: HeatmapMeta{YOrdinalDisplay: buckets},
+	})
+}
+
+func heatmapRowsMeta(layout HeatmapLayout) *FrameMeta {
+	meta := &FrameMeta{Type: FrameTypeHeatmapRows}
+	if layout == HeatmapLayoutLe {
+		meta.Custom = HeatmapMeta{YMatchWithLabel: HeatmapBucketLabel}
+	}
+	return meta
+}
+
+func heatmapBucketLabels(layout HeatmapLayout, bound string) Labels {
+	if layout != HeatmapLayoutLe {
+		return nil
+	}
+	return Labels{HeatmapBucketLabel: bound}
+}
+
+// heatmapMetaOf returns the HeatmapMeta of frame, which is a map after the frame was unmarshaled from JSON.
+func heatmapMetaOf(frame *Frame) HeatmapMeta {
+	var meta HeatmapMeta
+	if frame.Meta == nil || frame.Meta.Custom == nil {
+		return meta
+	}
+	switch custom := frame.Meta.Custom.(type) {
+	case HeatmapMeta:
+		return custom
+	case *HeatmapMeta:
+		return *custom
+	}
+	b, err := json.Marshal(frame.Meta.Custom)
+	if err == nil {
+		_ = json.Unmarshal(b, &meta)
+	}
+	return meta
+}
@@ -0,0 +1,111 @@
+package data_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestHeatmapRowsFromBuckets(t *testing.T) {
+	t0 := time.Unix(1700000000, 0).UTC()
+	t1 := t0.Add(time.Minute)
+	wide := data.NewFrame("latency",
+		data.NewField("time", nil, []time.Time{t0, t1}),
+		data.NewField("value", data.Labels{"le": "+Inf"}, []float64{10, 20}),
+		data.NewField("value", data.Labels{"le": "0.1"}, []float64{2, 5}),
+		data.NewField("value", data.Labels{"le": "1"}, []*float64{float64Ptr(6), nil}),
+		data.NewField("other", nil, []float64{1, 1}),
+	)
+
+	rows, err := data.HeatmapRowsFromBuckets(wide)
+	require.NoError(t, err)
+
+	expected := data.NewFrame("latency",
+		data.NewField("time", nil, []time.Time{t0, t1}),
+		data.NewField("0.1", data.Labels{"le": "0.1"}, []float64{2, 5}),
+		data.NewField("1", data.Labels{"le": "1"}, []*float64{float64Ptr(4), nil}),
+		data.NewField("+Inf", data.Labels{"le": "+Inf"}, []float64{4, 15}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeHeatmapRows, Custom: data.HeatmapMeta{YMatchWithLabel: "le"}})
+	if diff := cmp.Diff(expected, rows, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	wide.Fields = append(wide.Fields, data.NewField("value", data.Labels{"le": "1"}, []float64{1, 1}))
+	_, err = data.HeatmapRowsFromBuckets(wide)
+	require.EqualError(t, err, `more than one series for the bucket "1"`)
+
+	_, err = data.HeatmapRowsFromBuckets(data.NewFrame("", data.NewField("time", nil, []time.Time{t0}), data.NewField("v", nil, []float64{1})))
+	require.Error(t, err)
+}
+
+func TestHeatmapRowsAndCells(t *testing.T) {
+	t0 := time.Unix(1700000000, 0).UTC()
+	t1 := t0.Add(time.Minute)
+	rows, err := data.NewHeatmapRowsFrame("", []time.Time{t0, t1}, data.HeatmapLayoutLe,
+		data.HeatmapBucket{Bound: "1", Counts: []float64{1, 2}},
+		data.HeatmapBucket{Bound: "10", Counts: []float64{3, 4}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, data.Labels{"le": "10"}, rows.Fields[2].Labels)
+
+	cells, err := data.HeatmapRowsToCells(rows, "")
+	require.NoError(t, err)
+	expected, err := data.NewHeatmapCellsFrame("", data.HeatmapLayoutLe, []string{"1", "10"},
+		data.HeatmapCell{Time: t0, Bucket: 0, Count: 1},
+		data.HeatmapCell{Time: t0, Bucket: 1, Count: 3},
+		data.HeatmapCell{Time: t1, Bucket: 0, Count: 2},
+		data.HeatmapCell{Time: t1, Bucket: 1, Count: 4},
+	)
+	require.NoError(t, err)
+	if diff := cmp.Diff(expected, cells, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+	require.Equal(t, []string{"xMax", "yMax", "count"}, []string{cells.Fields[0].Name, cells.Fields[1].Name, cells.Fields[2].Name})
+
+	// the bucket metadata survives the JSON round trip
+	b, err := json.Marshal(cells)
+	require.NoError(t, err)
+	decoded := &data.Frame{}
+	require.NoError(t, json.Unmarshal(b, decoded))
+
+	back, err := data.HeatmapCellsToRows(decoded)
+	require.NoError(t, err)
+	expectedRows := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{t0, t1}),
+		data.NewField("1", data.Labels{"le": "1"}, []*float64{float64Ptr(1), float64Ptr(2)}),
+		data.NewField("10", data.Labels{"le": "10"}, []*float64{float64Ptr(3), float64Ptr(4)}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeHeatmapRows, Custom: data.HeatmapMeta{YMatchWithLabel: "le"}})
+	if diff := cmp.Diff(expectedRows, back, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = data.NewHeatmapCellsFrame("", data.HeatmapLayoutLe, []string{"1"}, data.HeatmapCell{Bucket: 1})
+	require.Error(t, err)
+	_, err = data.NewHeatmapRowsFrame("", []time.Time{t0}, data.HeatmapLayoutLe, data.HeatmapBucket{Bound: "1"})
+	require.Error(t, err)
+}
+
+func TestHeatmapCellsToRowsWithoutOrdinals(t *testing.T) {
+	t0 := time.Unix(1700000000, 0).UTC()
+	cells := data.NewFrame("",
+		data.NewField("x", nil, []time.Time{t0.Add(time.Minute), t0, t0}),
+		data.NewField("yMin", nil, []float64{0.5, 2, 0.5}),
+		data.NewField("count", nil, []int64{7, 8, 9}),
+	)
+	rows, err := data.HeatmapCellsToRows(cells)
+	require.NoError(t, err)
+
+	expected := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{t0, t0.Add(time.Minute)}),
+		data.NewField("0.5", nil, []*float64{float64Ptr(9), float64Ptr(7)}),
+		data.NewField("2", nil, []*float64{float64Ptr(8), nil}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeHeatmapRows})
+	if diff := cmp.Diff(expected, rows, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
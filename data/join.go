@@ -0,0 +1,378 @@
+package data
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JoinType is the kind of join performed by JoinFrames.
+type JoinType int
+
+const (
+	// JoinTypeOuter keeps the rows of all frames. Cells of frames that have no row for a key are null.
+	JoinTypeOuter JoinType = iota
+
+	// JoinTypeInner keeps only keys that are present in every frame.
+	JoinTypeInner
+
+	// JoinTypeLeft keeps the keys of the first frame. Cells of the other frames that have no row for a key are null.
+	JoinTypeLeft
+)
+
+func (t JoinType) String() string {
+	switch t {
+	case JoinTypeOuter:
+		return "outer"
+	case JoinTypeInner:
+		return "inner"
+	case JoinTypeLeft:
+		return "left"
+	}
+	return "unknown"
+}
+
+// JoinCollisionLabel is the label key added to a joined Field whose name and labels would otherwise
+// be identical to a Field that came from another frame.
+const JoinCollisionLabel = "frame"
+
+// JoinOptions configures JoinFrames.
+type JoinOptions struct {
+	// Type is the kind of join. The default is JoinTypeOuter.
+	Type JoinType
+
+	// Field is the name of the Field each frame is joined on.
+	// When empty, each frame is joined on its first time Field (the time index as per TimeSeriesSchema).
+	Field string
+}
+
+// ErrJoinFieldNotFound is returned by JoinFrames when a frame has no Field to join on.
+var ErrJoinFieldNotFound = errors.New("join field not found")
+
+// JoinFrames joins frames on a common key Field and returns a new Frame.
+//
+// The first Field of the result is the key Field, named after the key Field of the first frame,
+// followed by every other Field of each frame, in frame order. Rows are sorted ascending by key.
+// If a key occurs in several rows of the same frame, each combination of matching rows is
+// returned (as in SQL).
+//
+// When the join type can introduce missing cells (outer joins, and the right-hand frames of a left
+// join), the affected non-nullable Fields are converted to their nullable type and missing
+// cells are null.
+//
+// If two result Fields from different frames share the same Name and Labels, the later one gets the
+// additional label JoinCollisionLabel set to its frame's Name, or to its frame's index if the name does
+// not make it unique.
+//
+// An error is returned if a frame has no key Field, if the key Fields are not of the same type
+// (ignoring nullability), if a key value is null, or if the key Field is a JSON Field.
+//
+// When the key is a time Field and the result is a wide time series, the result's Meta.Type is
+// set to FrameTypeTimeSeriesWide.
+func JoinFrames(opts JoinOptions, frames ...*Frame) (*Frame, error) {
+	if len(frames) == 0 {
+		return nil, errors.New("no frames to join")
+	}
+
+	keyIndices := make([]int, len(frames))
+	for i, frame := range frames {
+		if _, err := frame.RowLen(); err != nil {
+			return nil, err
+		}
+		idx, err := joinKeyIndex(frame, opts.Field)
+		if err != nil {
+			return nil, fmt.Errorf("frame %d (%q): %w", i, frame.Name, err)
+		}
+		keyIndices[i] = idx
+	}
+
+	keyType := frames[0].Fields[keyIndices[0]].Type().NonNullableType()
+	if keyType.JSON() {
+		return nil, fmt.Errorf("can not join on JSON field %q", frames[0].Fields[keyIndices[0]].Name)
+	}
+	keys := make([][]interface{}, len(frames))
+	for i, frame := range frames {
+		keyField := frame.Fields[keyIndices[i]]
+		if ft := keyField.Type().NonNullableType(); ft != keyType {
+			return nil, fmt.Errorf("join field %q of frame %d is of type %s, but expected %s", keyField.Name, i, ft, keyType)
+		}
+		frameKeys, err := joinKeyValues(keyField)
+		if err != nil {
+			return nil, fmt.Errorf("frame %d (%q): %w", i, frame.Name, err)
+		}
+		keys[i] = frameKeys
+	}
+
+	rows := joinRows(opts.Type, keys)
+	sort.SliceStable(rows, func(i, j int) bool {
+		return compareJoinKeys(rows[i].key, rows[j].key) < 0
+	})
+
+	out := joinedFrame(opts.Type, frames, keyIndices, rows)
+	if out.Meta != nil {
+		// the joined frame does not necessarily have the structure of the first frame.
+		out.Meta.Type = FrameTypeUnknown
+		out.Meta.TypeVersion = FrameTypeVersion{}
+	}
+	if keyType == FieldTypeTime && out.TimeSeriesSchema().Type == TimeSeriesTypeWide {
+		if out.Meta == nil {
+			out.Meta = &FrameMeta{}
+		}
+		out.Meta.Type = FrameTypeTimeSeriesWide
+		out.Meta.TypeVersion = FrameTypeVersion{0, 1}
+	}
+	return out, nil
+}
+
+func joinKeyIndex(frame *Frame, name string) (int, error) {
+	if name == "" {
+		timeIndices := frame.TypeIndices(FieldTypeTime, FieldTypeNullableTime)
+		if len(timeIndices) == 0 {
+			return -1, fmt.Errorf("%w: frame has no time field", ErrJoinFieldNotFound)
+		}
+		return timeIndices[0], nil
+	}
+	_, idx := frame.FieldByName(name)
+	if idx == -1 {
+		return -1, fmt.Errorf("%w: frame has no field named %q", ErrJoinFieldNotFound, name)
+	}
+	return idx, nil
+}
+
+// decimalJoinKey is the key of a Decimal value: its plain notation without trailing fractional
+// zeros, so that equal Decimals of different scales are the same map key.
+type decimalJoinKey string
+
+func newDecimalJoinKey(d Decimal) decimalJoinKey {
+	s := d.String()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return decimalJoinKey(s)
+}
+
+func (k decimalJoinKey) decimal() Decimal {
+	d, _ := ParseDecimal(string(k))
+	return d
+}
+
+// joinKeyValues returns the concrete key values of field. time.Time and Decimal values are
+// converted so they can be used as map keys.
+func joinKeyValues(field *Field) ([]interface{}, error) {
+	vals := make([]interface{}, field.Len())
+	for i := range vals {
+		v, ok := field.ConcreteAt(i)
+		if !ok {
+			return nil, fmt.Errorf("join field %q has a null value at row %d", field.Name, i)
+		}
+		switch t := v.(type) {
+		case time.Time:
+			v = t.UnixNano()
+		case Decimal:
+			v = newDecimalJoinKey(t)
+		}
+		vals[i] = v
+	}
+	return vals, nil
+}
+
+// joinedRow is a row of the joined frame. rows holds the row index for each input frame,
+// or -1 when that frame has no row for the key.
+type joinedRow struct {
+	key  interface{}
+	rows []int
+}
+
+func joinRows(joinType JoinType, keys [][]interface{}) []joinedRow {
+	n := len(keys)
+	result := make([]joinedRow, 0, len(keys[0]))
+	for rowIdx, key := range keys[0] {
+		rows := make([]int, n)
+		for i := range rows {
+			rows[i] = -1
+		}
+		rows[0] = rowIdx
+		result = append(result, joinedRow{key: key, rows: rows})
+	}
+
+	for frameIdx := 1; frameIdx < n; frameIdx++ {
+		index := make(map[interface{}][]int)
+		var order []interface{}
+		for rowIdx, key := range keys[frameIdx] {
+			if _, ok := index[key]; !ok {
+				order = append(order, key)
+			}
+			index[key] = append(index[key], rowIdx)
+		}
+
+		seen := make(map[interface{}]struct{}, len(result))
+		next := make([]joinedRow, 0, len(result))
+		for _, jr := range result {
+			seen[jr.key] = struct{}{}
+			matches, ok := index[jr.key]
+			if !ok {
+				if joinType != JoinTypeInner {
+					next = append(next, jr)
+				}
+				continue
+			}
+			for _, m := range matches {
+				rows := append([]int(nil), jr.rows...)
+				rows[frameIdx] = m
+				next = append(next, joinedRow{key: jr.key, rows: rows})
+			}
+		}
+
+		if joinType == JoinTypeOuter {
+			for _, key := range order {
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				for _, m := range index[key] {
+					rows := make([]int, n)
+					for i := range rows {
+						rows[i] = -1
+					}
+					rows[frameIdx] = m
+					next = append(next, joinedRow{key: key, rows: rows})
+				}
+			}
+		}
+		result = next
+	}
+	return result
+}
+
+func compareJoinKeys(a, b interface{}) int {
+	switch av := a.(type) {
+	case int8:
+		return cmp.Compare(av, b.(int8))
+	case int16:
+		return cmp.Compare(av, b.(int16))
+	case int32:
+		return cmp.Compare(av, b.(int32))
+	case int64:
+		return cmp.Compare(av, b.(int64))
+	case uint8:
+		return cmp.Compare(av, b.(uint8))
+	case uint16:
+		return cmp.Compare(av, b.(uint16))
+	case uint32:
+		return cmp.Compare(av, b.(uint32))
+	case uint64:
+		return cmp.Compare(av, b.(uint64))
+	case float32:
+		return cmp.Compare(av, b.(float32))
+	case float64:
+		return cmp.Compare(av, b.(float64))
+	case string:
+		return cmp.Compare(av, b.(string))
+	case EnumItemIndex:
+		return cmp.Compare(av, b.(EnumItemIndex))
+	case time.Duration:
+		return cmp.Compare(av, b.(time.Duration))
+	case decimalJoinKey:
+		return av.decimal().Cmp(b.(decimalJoinKey).decimal())
+	case bool:
+		bv := b.(bool)
+		switch {
+		case av == bv:
+			return 0
+		case !av:
+			return -1
+		default:
+			return 1
+		}
+	}
+	return 0
+}
+
+// joinedFrame builds the result frame of JoinFrames from the joined rows.
+func joinedFrame(joinType JoinType, frames []*Frame, keyIndices []int, rows []joinedRow) *Frame {
+	first := frames[0]
+	firstKey := first.Fields[keyIndices[0]]
+
+	out := &Frame{
+		Name:  first.Name,
+		RefID: first.RefID,
+	}
+	if first.Meta != nil {
+		meta := *first.Meta
+		out.Meta = &meta
+	}
+
+	keyField := NewFieldFromFieldType(firstKey.Type().NonNullableType(), len(rows))
+	keyField.Name = firstKey.Name
+	keyField.Config = firstKey.Config
+	if firstKey.Labels != nil {
+		keyField.Labels = firstKey.Labels.Copy()
+	}
+	for rowIdx, jr := range rows {
+		for frameIdx, srcRow := range jr.rows {
+			if srcRow == -1 {
+				continue
+			}
+			v, _ := frames[frameIdx].ConcreteAt(keyIndices[frameIdx], srcRow)
+			keyField.SetConcrete(rowIdx, v)
+			break
+		}
+	}
+	out.Fields = append(out.Fields, keyField)
+
+	identities := make(map[string]struct{})
+	for frameIdx, frame := range frames {
+		promote := joinType == JoinTypeOuter || (joinType == JoinTypeLeft && frameIdx > 0)
+		for fieldIdx, field := range frame.Fields {
+			if fieldIdx == keyIndices[frameIdx] {
+				continue
+			}
+			ft := field.Type()
+			if promote {
+				ft = ft.NullableType()
+			}
+			outField := NewFieldFromFieldType(ft, len(rows))
+			outField.Name = field.Name
+			outField.Config = field.Config
+			if field.Labels != nil {
+				outField.Labels = field.Labels.Copy()
+			}
+			resolveJoinCollision(identities, outField, frame.Name, frameIdx)
+
+			for rowIdx, jr := range rows {
+				srcRow := jr.rows[frameIdx]
+				if srcRow == -1 {
+					continue
+				}
+				if v, ok := field.ConcreteAt(srcRow); ok {
+					outField.SetConcrete(rowIdx, v)
+				}
+			}
+			out.Fields = append(out.Fields, outField)
+		}
+	}
+	return out
+}
+
+// resolveJoinCollision adds the JoinCollisionLabel to field if its name and labels were already seen.
+func resolveJoinCollision(seen map[string]struct{}, field *Field, frameName string, frameIdx int) {
+	identity := func() string {
+		b, _ := json.Marshal([]interface{}{field.Name, field.Labels})
+		return string(b)
+	}
+
+	if _, ok := seen[identity()]; ok && frameIdx > 0 {
+		if field.Labels == nil {
+			field.Labels = Labels{}
+		}
+		field.Labels[JoinCollisionLabel] = frameName
+		if _, ok := seen[identity()]; ok || frameName == "" {
+			field.Labels[JoinCollisionLabel] = strconv.Itoa(frameIdx)
+		}
+	}
+	seen[identity()] = struct{}{}
+}
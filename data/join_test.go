@@ -0,0 +1,150 @@
+package data_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestJoinFrames(t *testing.T) {
+	t1 := time.Date(2020, 1, 2, 3, 4, 0, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+	t3 := t2.Add(time.Minute)
+
+	a := data.NewFrame("a",
+		data.NewField("time", nil, []time.Time{t2, t1}),
+		data.NewField("value", data.Labels{"host": "x"}, []float64{2, 1}),
+	)
+	b := data.NewFrame("b",
+		data.NewField("ts", nil, []*time.Time{&t3, &t2}),
+		data.NewField("value", data.Labels{"host": "x"}, []int64{30, 20}),
+	)
+
+	tests := []struct {
+		name     string
+		joinType data.JoinType
+		expected *data.Frame
+	}{
+		{
+			name:     "outer join on time",
+			joinType: data.JoinTypeOuter,
+			expected: data.NewFrame("a",
+				data.NewField("time", nil, []time.Time{t1, t2, t3}),
+				data.NewField("value", data.Labels{"host": "x"}, []*float64{float64Ptr(1), float64Ptr(2), nil}),
+				data.NewField("value", data.Labels{"host": "x", "frame": "b"}, []*int64{nil, int64Ptr(20), int64Ptr(30)}),
+			).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide, TypeVersion: data.FrameTypeVersion{0, 1}}),
+		},
+		{
+			name:     "inner join on time",
+			joinType: data.JoinTypeInner,
+			expected: data.NewFrame("a",
+				data.NewField("time", nil, []time.Time{t2}),
+				data.NewField("value", data.Labels{"host": "x"}, []float64{2}),
+				data.NewField("value", data.Labels{"host": "x", "frame": "b"}, []int64{20}),
+			).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide, TypeVersion: data.FrameTypeVersion{0, 1}}),
+		},
+		{
+			name:     "left join on time",
+			joinType: data.JoinTypeLeft,
+			expected: data.NewFrame("a",
+				data.NewField("time", nil, []time.Time{t1, t2}),
+				data.NewField("value", data.Labels{"host": "x"}, []float64{1, 2}),
+				data.NewField("value", data.Labels{"host": "x", "frame": "b"}, []*int64{nil, int64Ptr(20)}),
+			).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide, TypeVersion: data.FrameTypeVersion{0, 1}}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := data.JoinFrames(data.JoinOptions{Type: tt.joinType}, a, b)
+			require.NoError(t, err)
+			if diff := cmp.Diff(tt.expected, out, data.FrameTestCompareOptions()...); diff != "" {
+				t.Errorf("Result mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("join on key field with duplicate keys", func(t *testing.T) {
+		users := data.NewFrame("users",
+			data.NewField("id", nil, []string{"u1", "u2"}),
+			data.NewField("name", nil, []string{"ann", "bob"}),
+		)
+		orders := data.NewFrame("orders",
+			data.NewField("id", nil, []string{"u1", "u1", "u3"}),
+			data.NewField("total", nil, []float64{5, 7, 9}),
+		)
+		out, err := data.JoinFrames(data.JoinOptions{Type: data.JoinTypeOuter, Field: "id"}, users, orders)
+		require.NoError(t, err)
+
+		expected := data.NewFrame("users",
+			data.NewField("id", nil, []string{"u1", "u1", "u2", "u3"}),
+			data.NewField("name", nil, []*string{stringPtr("ann"), stringPtr("ann"), stringPtr("bob"), nil}),
+			data.NewField("total", nil, []*float64{float64Ptr(5), float64Ptr(7), nil, float64Ptr(9)}),
+		)
+		if diff := cmp.Diff(expected, out, data.FrameTestCompareOptions()...); diff != "" {
+			t.Errorf("Result mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("join on decimal and duration keys", func(t *testing.T) {
+		decimal := func(s string) data.Decimal {
+			d, err := data.ParseDecimal(s)
+			require.NoError(t, err)
+			return d
+		}
+		prices := data.NewFrame("prices",
+			data.NewField("price", nil, []data.Decimal{decimal("10.50"), decimal("2"), decimal("100")}),
+			data.NewField("a", nil, []int64{1, 2, 3}),
+		)
+		otherPrices := data.NewFrame("other",
+			data.NewField("price", nil, []*data.Decimal{pointer(decimal("2.000")), pointer(decimal("10.5"))}),
+			data.NewField("b", nil, []int64{20, 10}),
+		)
+		out, err := data.JoinFrames(data.JoinOptions{Type: data.JoinTypeInner, Field: "price"}, prices, otherPrices)
+		require.NoError(t, err)
+		expected := data.NewFrame("prices",
+			data.NewField("price", nil, []data.Decimal{decimal("2"), decimal("10.50")}),
+			data.NewField("a", nil, []int64{2, 1}),
+			data.NewField("b", nil, []int64{20, 10}),
+		)
+		if diff := cmp.Diff(expected, out, data.FrameTestCompareOptions()...); diff != "" {
+			t.Errorf("Result mismatch (-want +got):\n%s", diff)
+		}
+
+		spans := data.NewFrame("spans",
+			data.NewField("duration", nil, []time.Duration{time.Minute, time.Second}),
+			data.NewField("a", nil, []int64{1, 2}),
+		)
+		otherSpans := data.NewFrame("other",
+			data.NewField("duration", nil, []time.Duration{time.Hour, time.Second}),
+			data.NewField("b", nil, []int64{30, 20}),
+		)
+		out, err = data.JoinFrames(data.JoinOptions{Type: data.JoinTypeOuter, Field: "duration"}, spans, otherSpans)
+		require.NoError(t, err)
+		expected = data.NewFrame("spans",
+			data.NewField("duration", nil, []time.Duration{time.Second, time.Minute, time.Hour}),
+			data.NewField("a", nil, []*int64{int64Ptr(2), int64Ptr(1), nil}),
+			data.NewField("b", nil, []*int64{int64Ptr(20), nil, int64Ptr(30)}),
+		)
+		if diff := cmp.Diff(expected, out, data.FrameTestCompareOptions()...); diff != "" {
+			t.Errorf("Result mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := data.JoinFrames(data.JoinOptions{}, a, data.NewFrame("no time", data.NewField("v", nil, []float64{1})))
+		require.ErrorIs(t, err, data.ErrJoinFieldNotFound)
+
+		_, err = data.JoinFrames(data.JoinOptions{Field: "value"}, a, b)
+		require.Error(t, err, "key types differ")
+
+		_, err = data.JoinFrames(data.JoinOptions{}, a, data.NewFrame("null time",
+			data.NewField("time", nil, []*time.Time{nil}),
+			data.NewField("v", nil, []float64{1}),
+		))
+		require.Error(t, err, "null key")
+	})
+}
@@ -0,0 +1,311 @@
+package data
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MatchType is the operator of a LabelMatcher.
+type MatchType int
+
+const (
+	// MatchEqual matches labels with a value equal to the matcher's value (=).
+	MatchEqual MatchType = iota
+	// MatchNotEqual matches labels with a value not equal to the matcher's value (!=).
+	MatchNotEqual
+	// MatchRegexp matches labels with a value that fully matches the matcher's regular expression (=~).
+	MatchRegexp
+	// MatchNotRegexp matches labels with a value that does not fully match the matcher's regular expression (!~).
+	MatchNotRegexp
+)
+
+// String returns the PromQL operator of the MatchType.
+func (t MatchType) String() string {
+	switch t {
+	case MatchEqual:
+		return "="
+	case MatchNotEqual:
+		return "!="
+	case MatchRegexp:
+		return "=~"
+	case MatchNotRegexp:
+		return "!~"
+	default:
+		return fmt.Sprintf("MatchType(%d)", int(t))
+	}
+}
+
+// MetricNameLabel is the label of the metric name in label selectors such as `up{job="api"}`.
+const MetricNameLabel = "__name__"
+
+// LabelMatcher matches the value of a label, like a matcher of a PromQL series selector.
+// As in Prometheus, a missing label has the value "", so `env!="prod"` matches Labels without an env label.
+type LabelMatcher struct {
+	Type  MatchType
+	Name  string
+	Value string
+
+	re *regexp.Regexp
+}
+
+// NewLabelMatcher returns a LabelMatcher for the label name. For MatchRegexp and MatchNotRegexp,
+// value is a regular expression in RE2 syntax that must match the entire label value.
+func NewLabelMatcher(t MatchType, name, value string) (*LabelMatcher, error) {
+	m := &LabelMatcher{Type: t, Name: name, Value: value}
+	switch t {
+	case MatchEqual, MatchNotEqual:
+	case MatchRegexp, MatchNotRegexp:
+		re, err := regexp.Compile("^(?s:" + value + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression for label %q: %w", name, err)
+		}
+		m.re = re
+	default:
+		return nil, fmt.Errorf("invalid match type %d for label %q", int(t), name)
+	}
+	return m, nil
+}
+
+// Matches returns true if the label value v matches.
+func (m *LabelMatcher) Matches(v string) bool {
+	switch m.Type {
+	case MatchEqual:
+		return v == m.Value
+	case MatchNotEqual:
+		return v != m.Value
+	case MatchRegexp:
+		return m.re.MatchString(v)
+	case MatchNotRegexp:
+		return !m.re.MatchString(v)
+	default:
+		return false
+	}
+}
+
+// String returns the matcher in PromQL syntax, for example `job=~"api|web"`.
+func (m *LabelMatcher) String() string {
+	return m.Name + m.Type.String() + strconv.Quote(m.Value)
+}
+
+// LabelMatchers are the matchers of a label selector. Labels match if they match all matchers.
+type LabelMatchers []*LabelMatcher
+
+// Matches returns true if l matches all matchers. A missing label has the value "".
+func (ms LabelMatchers) Matches(l Labels) bool {
+	for _, m := range ms {
+		if !m.Matches(l[m.Name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the matchers as a PromQL label selector, for example `{job="api", env!="dev"}`.
+func (ms LabelMatchers) String() string {
+	parts := make([]string, len(ms))
+	for i, m := range ms {
+		parts[i] = m.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// ParseLabelMatchers parses a PromQL series selector such as `up{job="api", env=~"prod|staging"}`
+// into LabelMatchers. The metric name and the braces are optional, so `job="api"` and `up` are valid
+// too. A metric name is matched with MetricNameLabel. Values can be quoted with double quotes,
+// single quotes or backticks, with the escape sequences of Go string literals.
+func ParseLabelMatchers(s string) (LabelMatchers, error) {
+	p := &matcherParser{input: s}
+	matchers, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", s, err)
+	}
+	return matchers, nil
+}
+
+type matcherParser struct {
+	input string
+	pos   int
+}
+
+func (p *matcherParser) parse() (LabelMatchers, error) {
+	var matchers LabelMatchers
+	p.skipSpace()
+	if p.pos < len(p.input) && p.input[p.pos] != '{' {
+		name := p.readIdentifier(true)
+		if name == "" {
+			return nil, p.errorf("expected a metric name or '{'")
+		}
+		p.skipSpace()
+		if p.pos < len(p.input) && strings.ContainsRune("=!", rune(p.input[p.pos])) {
+			// matchers without braces
+			p.pos = 0
+			return p.parseMatchers(matchers, false)
+		}
+		m, err := NewLabelMatcher(MatchEqual, MetricNameLabel, name)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+	}
+
+	p.skipSpace()
+	if p.pos == len(p.input) {
+		return matchers, nil
+	}
+	if p.input[p.pos] != '{' {
+		return nil, p.errorf("expected '{'")
+	}
+	p.pos++
+	return p.parseMatchers(matchers, true)
+}
+
+// parseMatchers parses a comma separated list of matchers, that ends with '}' if braced.
+func (p *matcherParser) parseMatchers(matchers LabelMatchers, braced bool) (LabelMatchers, error) {
+	for {
+		p.skipSpace()
+		if p.pos == len(p.input) {
+			if braced {
+				return nil, p.errorf("expected '}'")
+			}
+			return matchers, nil
+		}
+		if braced && p.input[p.pos] == '}' {
+			p.pos++
+			p.skipSpace()
+			if p.pos != len(p.input) {
+				return nil, p.errorf("unexpected input after '}'")
+			}
+			return matchers, nil
+		}
+
+		m, err := p.parseMatcher()
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+
+		p.skipSpace()
+		if p.pos < len(p.input) && p.input[p.pos] == ',' {
+			p.pos++
+			continue
+		}
+		if p.pos < len(p.input) && !(braced && p.input[p.pos] == '}') {
+			return nil, p.errorf("expected ',' or '}'")
+		}
+	}
+}
+
+func (p *matcherParser) parseMatcher() (*LabelMatcher, error) {
+	name := p.readIdentifier(false)
+	if name == "" {
+		return nil, p.errorf("expected a label name")
+	}
+	p.skipSpace()
+
+	var t MatchType
+	switch {
+	case strings.HasPrefix(p.input[p.pos:], "=~"):
+		t = MatchRegexp
+	case strings.HasPrefix(p.input[p.pos:], "!~"):
+		t = MatchNotRegexp
+	case strings.HasPrefix(p.input[p.pos:], "!="):
+		t = MatchNotEqual
+	case strings.HasPrefix(p.input[p.pos:], "="):
+		t = MatchEqual
+	default:
+		return nil, p.errorf("expected one of =, !=, =~ or !~ after label %q", name)
+	}
+	p.pos += len(t.String())
+	p.skipSpace()
+
+	value, err := p.readString()
+	if err != nil {
+		return nil, err
+	}
+	return NewLabelMatcher(t, name, value)
+}
+
+// readIdentifier reads a label name, or a metric name which may also contain colons.
+func (p *matcherParser) readIdentifier(metricName bool) string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		isLetter := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (metricName && c == ':')
+		isDigit := c >= '0' && c <= '9'
+		if !isLetter && !(isDigit && p.pos > start) {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// readString reads a quoted string.
+func (p *matcherParser) readString() (string, error) {
+	if p.pos == len(p.input) {
+		return "", p.errorf("expected a quoted label value")
+	}
+	quote := p.input[p.pos]
+	if quote != '"' && quote != '\'' && quote != '`' {
+		return "", p.errorf("expected a quoted label value")
+	}
+	end := p.pos + 1
+	for ; end < len(p.input); end++ {
+		if p.input[end] == '\\' && quote != '`' {
+			end++
+			continue
+		}
+		if p.input[end] == quote {
+			break
+		}
+	}
+	if end >= len(p.input) {
+		return "", p.errorf("unterminated label value")
+	}
+	raw := p.input[p.pos : end+1]
+	p.pos = end + 1
+
+	if quote == '\'' {
+		raw = singleToDoubleQuoted(raw)
+	}
+	value, err := strconv.Unquote(raw)
+	if err != nil {
+		return "", p.errorf("invalid label value %s", raw)
+	}
+	return value, nil
+}
+
+// singleToDoubleQuoted converts a single quoted string literal to the double quoted literal of the same string.
+func singleToDoubleQuoted(raw string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	content := raw[1 : len(raw)-1]
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; {
+		case c == '\\' && i+1 < len(content):
+			if content[i+1] != '\'' {
+				sb.WriteByte(c)
+			}
+			i++
+			sb.WriteByte(content[i])
+		case c == '"':
+			sb.WriteString(`\"`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func (p *matcherParser) skipSpace() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\n\r", rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *matcherParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
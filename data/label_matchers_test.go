@@ -0,0 +1,75 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestParseLabelMatchers(t *testing.T) {
+	for _, tt := range []struct {
+		in       string
+		expected string
+	}{
+		{in: `{job="api"}`, expected: `{job="api"}`},
+		{in: `up{job="api", env=~"prod|staging",}`, expected: `{__name__="up", job="api", env=~"prod|staging"}`},
+		{in: `node:cpu:rate5m`, expected: `{__name__="node:cpu:rate5m"}`},
+		{in: ` job != 'a\'b"c' , path!~` + "`/api/.*`", expected: `{job!="a'b\"c", path!~"/api/.*"}`},
+		{in: `{name="line\nbreaké"}`, expected: `{name="line\nbreaké"}`},
+		{in: `{}`, expected: `{}`},
+		{in: ``, expected: `{}`},
+	} {
+		t.Run(tt.in, func(t *testing.T) {
+			matchers, err := data.ParseLabelMatchers(tt.in)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, matchers.String())
+		})
+	}
+
+	for _, in := range []string{
+		`{job="api"`,
+		`{job="api"} extra`,
+		`{job=api}`,
+		`{job="api}`,
+		`{job=="api"}`,
+		`{job="api" env="dev"}`,
+		`{1job="api"}`,
+		`{job=~"("}`,
+		`up down`,
+		`"up"`,
+	} {
+		_, err := data.ParseLabelMatchers(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestLabelMatchersMatches(t *testing.T) {
+	labels := data.Labels{"job": "api", "env": "prod"}
+	for _, tt := range []struct {
+		selector string
+		matches  bool
+	}{
+		{`{job="api"}`, true},
+		{`{job="api", env="dev"}`, false},
+		{`{job!="web"}`, true},
+		{`{env=~"pro"}`, false}, // regular expressions match the whole value
+		{`{env=~"pro.*|dev"}`, true},
+		{`{env!~"prod"}`, false},
+		{`{missing=""}`, true}, // missing labels have an empty value
+		{`{missing!=""}`, false},
+		{`{missing=~".*"}`, true},
+		{`{}`, true},
+	} {
+		matchers, err := data.ParseLabelMatchers(tt.selector)
+		require.NoError(t, err)
+		require.Equal(t, tt.matches, matchers.Matches(labels), tt.selector)
+	}
+
+	m, err := data.NewLabelMatcher(data.MatchRegexp, "multi", "a.b")
+	require.NoError(t, err)
+	require.True(t, m.Matches("a\nb"))
+	_, err = data.NewLabelMatcher(data.MatchType(10), "job", "api")
+	require.Error(t, err)
+}
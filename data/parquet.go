@@ -0,0 +1,118 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+)
+
+// MarshalParquet converts the Frame to an arrow table (see FrameToArrowTable) and returns the
+// bytes of a Parquet file holding that table.
+//
+// The Frame's Name, RefID and Meta are stored in the Parquet file key/value metadata using the
+// same keys as the Arrow table metadata. The serialized Arrow schema is stored as well so field
+// types, nullability, labels and config survive a round trip through UnmarshalParquetFrame.
+// All fields of a Frame must be of the same length or an error is returned.
+func (f *Frame) MarshalParquet() ([]byte, error) {
+	table, err := FrameToArrowTable(f)
+	if err != nil {
+		return nil, err
+	}
+	defer table.Release()
+
+	props := parquet.NewWriterProperties(
+		parquet.WithVersion(parquet.V2_LATEST), // needed to keep nanosecond timestamps
+		parquet.WithCompression(compress.Codecs.Snappy),
+	)
+	arrProps := pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema())
+
+	var buf bytes.Buffer
+	// A chunk size of at least one row so frames without rows still produce a valid file.
+	chunkSize := max(table.NumRows(), 1)
+	if err := pqarrow.WriteTable(table, &buf, chunkSize, props, arrProps); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalParquetFrame converts the bytes of a Parquet file to a Frame.
+// See Frame.MarshalParquet for the inverse operation.
+//
+// Parquet files that were not written by MarshalParquet are read according to the Arrow
+// types of their columns; they have no labels, config or Meta.
+func UnmarshalParquetFrame(b []byte) (*Frame, error) {
+	pqReader, err := file.NewParquetReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = pqReader.Close() }()
+
+	fR, err := pqarrow.NewFileReader(pqReader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := fR.Schema()
+	if err != nil {
+		return nil, err
+	}
+	frame := &Frame{}
+	if err := populateFrameFromSchema(schema, frame); err != nil {
+		return nil, err
+	}
+
+	nullable, err := initializeFrameFields(schema, frame)
+	if err != nil {
+		return nil, err
+	}
+
+	rr, err := fR.GetRecordReader(context.Background(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rr.Release()
+
+	if err = populateFrameFields(rr, nullable, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+// UnmarshalParquetFrames decodes a slice of Parquet encoded frames to Frames by calling
+// UnmarshalParquetFrame on each encoded frame.
+// If an error occurs Frames will be nil.
+func UnmarshalParquetFrames(bFrames [][]byte) (Frames, error) {
+	frames := make(Frames, len(bFrames))
+	var err error
+	for i, encodedFrame := range bFrames {
+		frames[i], err = UnmarshalParquetFrame(encodedFrame)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return frames, nil
+}
+
+// MarshalParquet encodes Frames into a slice of []byte using *Frame's MarshalParquet method on each Frame.
+// If an error occurs [][]byte will be nil.
+// See UnmarshalParquetFrames for the inverse operation.
+func (frames Frames) MarshalParquet() ([][]byte, error) {
+	bs := make([][]byte, len(frames))
+	var err error
+	for i, frame := range frames {
+		if frame == nil {
+			return nil, errors.New("frame can not be nil")
+		}
+		bs[i], err = frame.MarshalParquet()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bs, nil
+}
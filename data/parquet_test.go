@@ -0,0 +1,47 @@
+package data_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestEncodeAndDecodeParquet(t *testing.T) {
+	df := goldenDF()
+
+	b, err := df.MarshalParquet()
+	require.NoError(t, err)
+
+	newDf, err := data.UnmarshalParquetFrame(b)
+	require.NoError(t, err)
+
+	if diff := cmp.Diff(df, newDf, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEncodeAndDecodeParquetEmptyFrame(t *testing.T) {
+	frame := data.NewFrame("empty",
+		data.NewField("time", nil, []time.Time{}),
+		data.NewField("value", data.Labels{"a": "b"}, []*float64{}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide})
+	frame.RefID = "A"
+
+	b, err := frame.MarshalParquet()
+	require.NoError(t, err)
+
+	decoded, err := data.UnmarshalParquetFrame(b)
+	require.NoError(t, err)
+	if diff := cmp.Diff(frame, decoded, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFramesMarshalParquetNilFrame(t *testing.T) {
+	_, err := data.Frames{nil}.MarshalParquet()
+	require.Error(t, err)
+}
@@ -0,0 +1,8 @@
+// Package reduce reduces the values of numeric data.Fields to single values with Grafana's standard
+// reducers (the calculations of the frontend's "Reduce" transformation and stat panel), and uses them to
+// fill FieldConfig.Min and FieldConfig.Max, to create data.QueryStats and to reduce frames into
+// numeric wide frames, for example as the reduce step of an alert query.
+//
+// Null and NaN values are ignored by all reducers except First and Last, which return the raw first
+// and last values of the Field.
+package reduce
@@ -0,0 +1,466 @@
+package reduce
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// ReducerID is the ID of a reducer. The IDs are the ones of the reducers of the Grafana frontend.
+type ReducerID string
+
+const (
+	// Sum is the sum of the values, 0 if there are none.
+	Sum ReducerID = "sum"
+	// Max is the largest value.
+	Max ReducerID = "max"
+	// Min is the smallest value.
+	Min ReducerID = "min"
+	// LogMin is the smallest positive value, for logarithmic scales.
+	LogMin ReducerID = "logmin"
+	// Mean is the arithmetic mean of the values.
+	Mean ReducerID = "mean"
+	// Variance is the population variance of the values.
+	Variance ReducerID = "variance"
+	// StdDev is the population standard deviation of the values.
+	StdDev ReducerID = "stdDev"
+	// Median is the middle value, or the mean of the two middle values if the number of values is even.
+	Median ReducerID = "median"
+
+	// First is the first value of the Field, which is null if the Field's first value is null or NaN.
+	First ReducerID = "first"
+	// Last is the last value of the Field, which is null if the Field's last value is null or NaN.
+	Last ReducerID = "last"
+	// FirstNotNull is the first value.
+	FirstNotNull ReducerID = "firstNotNull"
+	// LastNotNull is the last value.
+	LastNotNull ReducerID = "lastNotNull"
+
+	// Count is the number of values.
+	Count ReducerID = "count"
+	// Range is the difference between Max and Min.
+	Range ReducerID = "range"
+	// Diff is the difference between LastNotNull and FirstNotNull.
+	Diff ReducerID = "diff"
+	// DiffPercent is Diff as a fraction of FirstNotNull, for example 0.5 for an increase from 2 to 3.
+	DiffPercent ReducerID = "diffperc"
+	// Delta is the cumulative increase of a counter: the sum of the increases between consecutive values,
+	// where a decrease is a counter reset and the value after the reset counts as its increase.
+	Delta ReducerID = "delta"
+	// Step is the smallest difference between consecutive values.
+	Step ReducerID = "step"
+	// ChangeCount is the number of times the value changes between consecutive values.
+	ChangeCount ReducerID = "changeCount"
+	// DistinctCount is the number of distinct values.
+	DistinctCount ReducerID = "distinctCount"
+
+	// AllIsNull is 1 if the Field has no values (all are null or NaN), 0 otherwise.
+	AllIsNull ReducerID = "allIsNull"
+	// AllIsZero is 1 if the Field has values and all of them are 0, 0 otherwise.
+	AllIsZero ReducerID = "allIsZero"
+)
+
+// ErrNoValue is returned when a reducer has no value for a Field, for example the Min of a Field
+// that only has null values, and the result can not be null.
+var ErrNoValue = errors.New("the reducer has no value")
+
+// Percentile returns the ID of the reducer of the p-th percentile, for example "p95" for Percentile(95).
+// The percentile is the nearest-rank value of the sorted values. p must be between 1 and 99.
+func Percentile(p int) ReducerID {
+	return ReducerID("p" + strconv.Itoa(p))
+}
+
+// Valid returns true if id is the ID of a reducer of this package.
+func (id ReducerID) Valid() bool {
+	_, err := reducerFor(id)
+	return err == nil
+}
+
+// series holds the values of a Field that reducers work on.
+type series struct {
+	// values are the values of the Field that are not null or NaN, in order.
+	values []float64
+	// first and last are the first and last values of the Field, nil if they are null or NaN.
+	first, last *float64
+}
+
+type reducer func(s *series) *float64
+
+var reducers = map[ReducerID]reducer{
+	Sum: func(s *series) *float64 {
+		var sum float64
+		for _, v := range s.values {
+			sum += v
+		}
+		return &sum
+	},
+	Max: ifValues(func(values []float64) float64 {
+		m := values[0]
+		for _, v := range values[1:] {
+			m = math.Max(m, v)
+		}
+		return m
+	}),
+	Min: ifValues(func(values []float64) float64 {
+		m := values[0]
+		for _, v := range values[1:] {
+			m = math.Min(m, v)
+		}
+		return m
+	}),
+	LogMin: func(s *series) *float64 {
+		var m *float64
+		for _, v := range s.values {
+			if v > 0 && (m == nil || v < *m) {
+				m = &v
+			}
+		}
+		return m
+	},
+	Mean:     ifValues(mean),
+	Variance: ifValues(variance),
+	StdDev: ifValues(func(values []float64) float64 {
+		return math.Sqrt(variance(values))
+	}),
+	Median: ifValues(func(values []float64) float64 {
+		sorted := sortedCopy(values)
+		mid := len(sorted) / 2
+		if len(sorted)%2 == 0 {
+			return (sorted[mid-1] + sorted[mid]) / 2
+		}
+		return sorted[mid]
+	}),
+
+	First: func(s *series) *float64 {
+		return s.first
+	},
+	Last: func(s *series) *float64 {
+		return s.last
+	},
+	FirstNotNull: ifValues(func(values []float64) float64 {
+		return values[0]
+	}),
+	LastNotNull: ifValues(func(values []float64) float64 {
+		return values[len(values)-1]
+	}),
+
+	Count: func(s *series) *float64 {
+		return ptr(float64(len(s.values)))
+	},
+	Range: ifValues(func(values []float64) float64 {
+		lowest, highest := values[0], values[0]
+		for _, v := range values[1:] {
+			lowest, highest = math.Min(lowest, v), math.Max(highest, v)
+		}
+		return highest - lowest
+	}),
+	Diff: ifValues(func(values []float64) float64 {
+		return values[len(values)-1] - values[0]
+	}),
+	DiffPercent: ifValues(func(values []float64) float64 {
+		return (values[len(values)-1] - values[0]) / values[0]
+	}),
+	Delta: ifValues(func(values []float64) float64 {
+		var delta float64
+		for i := 1; i < len(values); i++ {
+			if values[i] < values[i-1] {
+				delta += values[i] // counter reset
+				continue
+			}
+			delta += values[i] - values[i-1]
+		}
+		return delta
+	}),
+	Step: func(s *series) *float64 {
+		if len(s.values) < 2 {
+			return nil
+		}
+		step := math.Inf(1)
+		for i := 1; i < len(s.values); i++ {
+			step = math.Min(step, s.values[i]-s.values[i-1])
+		}
+		return &step
+	},
+	ChangeCount: func(s *series) *float64 {
+		var changes float64
+		for i := 1; i < len(s.values); i++ {
+			if s.values[i] != s.values[i-1] {
+				changes++
+			}
+		}
+		return &changes
+	},
+	DistinctCount: func(s *series) *float64 {
+		distinct := make(map[float64]struct{}, len(s.values))
+		for _, v := range s.values {
+			distinct[v] = struct{}{}
+		}
+		return ptr(float64(len(distinct)))
+	},
+
+	AllIsNull: func(s *series) *float64 {
+		return boolValue(len(s.values) == 0)
+	},
+	AllIsZero: func(s *series) *float64 {
+		for _, v := range s.values {
+			if v != 0 {
+				return boolValue(false)
+			}
+		}
+		return boolValue(len(s.values) > 0)
+	},
+}
+
+func reducerFor(id ReducerID) (reducer, error) {
+	if r, ok := reducers[id]; ok {
+		return r, nil
+	}
+	if p, ok := strings.CutPrefix(string(id), "p"); ok {
+		if n, err := strconv.Atoi(p); err == nil && n >= 1 && n <= 99 && strconv.Itoa(n) == p {
+			return ifValues(func(values []float64) float64 {
+				sorted := sortedCopy(values)
+				return sorted[int(math.Round(float64(len(sorted)-1)*float64(n)/100))]
+			}), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown reducer %q", id)
+}
+
+// ifValues returns a reducer that returns nil if there are no values and otherwise f of the values.
+func ifValues(f func(values []float64) float64) reducer {
+	return func(s *series) *float64 {
+		if len(s.values) == 0 {
+			return nil
+		}
+		return ptr(f(s.values))
+	}
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func variance(values []float64) float64 {
+	m := mean(values)
+	var sum float64
+	for _, v := range values {
+		sum += (v - m) * (v - m)
+	}
+	return sum / float64(len(values))
+}
+
+func sortedCopy(values []float64) []float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	return sorted
+}
+
+func boolValue(b bool) *float64 {
+	if b {
+		return ptr(1)
+	}
+	return ptr(0)
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
+
+// newSeries reads the values of field, which must be numeric.
+func newSeries(field *data.Field) (*series, error) {
+	if !field.Type().Numeric() {
+		return nil, fmt.Errorf("can not reduce field %q of type %s, a numeric field is required", field.Name, field.Type())
+	}
+	s := &series{values: make([]float64, 0, field.Len())}
+	for i := 0; i < field.Len(); i++ {
+		v, err := field.NullableFloatAt(i)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil && math.IsNaN(*v) {
+			v = nil
+		}
+		if i == 0 {
+			s.first = v
+		}
+		if i == field.Len()-1 {
+			s.last = v
+		}
+		if v != nil {
+			s.values = append(s.values, *v)
+		}
+	}
+	return s, nil
+}
+
+// Field returns the value of the reducer id over the values of field, which must be numeric.
+// The value is nil if the reducer has no value, for example the Min of a Field that only has null values.
+func Field(field *data.Field, id ReducerID) (*float64, error) {
+	values, err := Calculate(field, id)
+	if err != nil {
+		return nil, err
+	}
+	return values[id], nil
+}
+
+// Calculate returns the values of the reducers ids over the values of field, which must be numeric,
+// reading the values of field once. A value is nil if its reducer has no value (see Field).
+func Calculate(field *data.Field, ids ...ReducerID) (map[ReducerID]*float64, error) {
+	rs := make(map[ReducerID]reducer, len(ids))
+	for _, id := range ids {
+		r, err := reducerFor(id)
+		if err != nil {
+			return nil, err
+		}
+		rs[id] = r
+	}
+
+	s, err := newSeries(field)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[ReducerID]*float64, len(rs))
+	for id, r := range rs {
+		values[id] = r(s)
+	}
+	return values, nil
+}
+
+// Stat returns a QueryStat with the value of the reducer id over the values of field and the given display name.
+// ErrNoValue is returned if the reducer has no value.
+func Stat(displayName string, field *data.Field, id ReducerID) (data.QueryStat, error) {
+	v, err := Field(field, id)
+	if err != nil {
+		return data.QueryStat{}, err
+	}
+	if v == nil {
+		return data.QueryStat{}, fmt.Errorf("%w: %s of field %q", ErrNoValue, id, field.Name)
+	}
+	return data.QueryStat{
+		FieldConfig: data.FieldConfig{DisplayName: displayName},
+		Value:       *v,
+	}, nil
+}
+
+// SetMinMax sets the Min and Max of the FieldConfig of each numeric Field of fields to the smallest and
+// largest value of the Field, creating the FieldConfig if it is nil, so the frontend can skip the calculation.
+// Fields that are not numeric or have no values are left unchanged.
+func SetMinMax(fields ...*data.Field) error {
+	for _, field := range fields {
+		if !field.Type().Numeric() {
+			continue
+		}
+		values, err := Calculate(field, Min, Max)
+		if err != nil {
+			return err
+		}
+		setMinMax(field, values[Min], values[Max])
+	}
+	return nil
+}
+
+// SetSharedMinMax is like SetMinMax, but sets the Min and Max of all numeric Fields of fields to the
+// smallest and largest value of any of them, so they share a scale.
+func SetSharedMinMax(fields ...*data.Field) error {
+	var lowest, highest *float64
+	var numeric []*data.Field
+	for _, field := range fields {
+		if !field.Type().Numeric() {
+			continue
+		}
+		numeric = append(numeric, field)
+		values, err := Calculate(field, Min, Max)
+		if err != nil {
+			return err
+		}
+		if v := values[Min]; v != nil && (lowest == nil || *v < *lowest) {
+			lowest = v
+		}
+		if v := values[Max]; v != nil && (highest == nil || *v > *highest) {
+			highest = v
+		}
+	}
+	for _, field := range numeric {
+		setMinMax(field, lowest, highest)
+	}
+	return nil
+}
+
+func setMinMax(field *data.Field, lowest, highest *float64) {
+	if lowest == nil || highest == nil {
+		return
+	}
+	if field.Config == nil {
+		field.Config = &data.FieldConfig{}
+	}
+	field.Config.SetMin(*lowest).SetMax(*highest)
+}
+
+// Frame reduces every numeric Field of frame with the reducer id into a numeric wide frame: a frame with one
+// row and, for each numeric Field, a nullable float64 Field with the Field's name, labels and config.
+// A long time series frame (see data.FrameTypeTimeSeriesLong) is converted to a wide frame first, so its
+// string Fields become the labels of the reduced Fields. Other Fields that are not numeric are dropped.
+func Frame(frame *data.Frame, id ReducerID) (*data.Frame, error) {
+	return Frames(data.Frames{frame}, id)
+}
+
+// Frames reduces the numeric Fields of all frames (for example the frames of a multi time series response)
+// into a single numeric wide frame, like Frame. The reduced frame has the name and RefID of the first frame.
+func Frames(frames data.Frames, id ReducerID) (*data.Frame, error) {
+	if _, err := reducerFor(id); err != nil {
+		return nil, err
+	}
+
+	reduced := data.NewFrame("")
+	if len(frames) > 0 {
+		reduced.Name, reduced.RefID = frames[0].Name, frames[0].RefID
+	}
+	reduced.SetMeta(&data.FrameMeta{
+		Type:        data.FrameTypeNumericWide,
+		TypeVersion: data.FrameTypeVersion{0, 1},
+	})
+
+	for _, frame := range frames {
+		if frame.Meta != nil && frame.Meta.Type == data.FrameTypeTimeSeriesLong {
+			// LongToWide sets the type of the Meta of its input on the wide frame, so it converts a copy
+			long := *frame
+			meta := *frame.Meta
+			long.Meta = &meta
+			wide, err := data.LongToWide(&long, nil)
+			if err != nil {
+				return nil, err
+			}
+			frame = wide
+		}
+		for _, field := range frame.Fields {
+			if !field.Type().Numeric() {
+				continue
+			}
+			v, err := Field(field, id)
+			if err != nil {
+				return nil, err
+			}
+			var labels data.Labels
+			if field.Labels != nil {
+				labels = field.Labels.Copy()
+			}
+			out := data.NewField(field.Name, labels, []*float64{v})
+			if field.Config != nil {
+				config := *field.Config
+				out.Config = &config
+			}
+			reduced.Fields = append(reduced.Fields, out)
+		}
+	}
+	return reduced, nil
+}
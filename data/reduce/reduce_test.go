@@ -0,0 +1,154 @@
+package reduce_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/reduce"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestField(t *testing.T) {
+	field := data.NewField("value", nil, []*float64{nil, ptr(3.0), ptr(1.0), ptr(math.NaN()), ptr(4.0), ptr(1.0), ptr(5.0), nil})
+
+	for _, tt := range []struct {
+		id       reduce.ReducerID
+		expected *float64
+	}{
+		{reduce.Sum, ptr(14.0)},
+		{reduce.Max, ptr(5.0)},
+		{reduce.Min, ptr(1.0)},
+		{reduce.LogMin, ptr(1.0)},
+		{reduce.Mean, ptr(2.8)},
+		{reduce.Variance, ptr(2.56)},
+		{reduce.StdDev, ptr(1.6)},
+		{reduce.Median, ptr(3.0)},
+		{reduce.First, nil},
+		{reduce.Last, nil},
+		{reduce.FirstNotNull, ptr(3.0)},
+		{reduce.LastNotNull, ptr(5.0)},
+		{reduce.Count, ptr(5.0)},
+		{reduce.Range, ptr(4.0)},
+		{reduce.Diff, ptr(2.0)},
+		{reduce.DiffPercent, ptr(2.0 / 3.0)},
+		{reduce.Delta, ptr(9.0)}, // 3 -> 1 reset (+1), 1 -> 4 (+3), 4 -> 1 reset (+1), 1 -> 5 (+4)
+		{reduce.Step, ptr(-3.0)},
+		{reduce.ChangeCount, ptr(4.0)},
+		{reduce.DistinctCount, ptr(4.0)},
+		{reduce.AllIsNull, ptr(0.0)},
+		{reduce.AllIsZero, ptr(0.0)},
+		{reduce.Percentile(50), ptr(3.0)},
+		{reduce.Percentile(95), ptr(5.0)},
+		{reduce.Percentile(1), ptr(1.0)},
+	} {
+		t.Run(string(tt.id), func(t *testing.T) {
+			v, err := reduce.Field(field, tt.id)
+			require.NoError(t, err)
+			if tt.expected == nil {
+				require.Nil(t, v)
+				return
+			}
+			require.NotNil(t, v)
+			require.InDelta(t, *tt.expected, *v, 1e-9)
+		})
+	}
+}
+
+func TestFieldWithoutValues(t *testing.T) {
+	field := data.NewField("value", nil, []*int64{nil, nil})
+	values, err := reduce.Calculate(field, reduce.Sum, reduce.Count, reduce.Min, reduce.Percentile(90), reduce.AllIsNull, reduce.AllIsZero, reduce.Step)
+	require.NoError(t, err)
+	require.Equal(t, map[reduce.ReducerID]*float64{
+		reduce.Sum:            ptr(0.0),
+		reduce.Count:          ptr(0.0),
+		reduce.Min:            nil,
+		reduce.Percentile(90): nil,
+		reduce.AllIsNull:      ptr(1.0),
+		reduce.AllIsZero:      ptr(0.0),
+		reduce.Step:           nil,
+	}, values)
+
+	_, err = reduce.Stat("min", field, reduce.Min)
+	require.ErrorIs(t, err, reduce.ErrNoValue)
+}
+
+func TestFieldErrors(t *testing.T) {
+	_, err := reduce.Field(data.NewField("value", nil, []string{"a"}), reduce.Sum)
+	require.Error(t, err)
+
+	for _, id := range []reduce.ReducerID{"unknown", "p0", "p100", "p05", "p"} {
+		require.False(t, id.Valid(), id)
+		_, err = reduce.Field(data.NewField("value", nil, []float64{1}), id)
+		require.Error(t, err, id)
+	}
+	require.True(t, reduce.Percentile(99).Valid())
+}
+
+func TestStat(t *testing.T) {
+	stat, err := reduce.Stat("Total", data.NewField("value", nil, []int32{1, 2, 3}), reduce.Sum)
+	require.NoError(t, err)
+	require.Equal(t, data.QueryStat{FieldConfig: data.FieldConfig{DisplayName: "Total"}, Value: 6}, stat)
+}
+
+func TestSetMinMax(t *testing.T) {
+	a := data.NewField("a", nil, []float64{1, 5})
+	b := data.NewField("b", nil, []*float64{nil, ptr(-2.0)}).SetConfig(&data.FieldConfig{Unit: "ms"})
+	empty := data.NewField("empty", nil, []*float64{nil})
+	name := data.NewField("name", nil, []string{"x", "y"})
+
+	require.NoError(t, reduce.SetMinMax(a, b, empty, name))
+	require.Equal(t, (&data.FieldConfig{}).SetMin(1).SetMax(5), a.Config)
+	require.Equal(t, (&data.FieldConfig{Unit: "ms"}).SetMin(-2).SetMax(-2), b.Config)
+	require.Nil(t, empty.Config)
+	require.Nil(t, name.Config)
+
+	require.NoError(t, reduce.SetSharedMinMax(a, b, empty, name))
+	require.Equal(t, (&data.FieldConfig{}).SetMin(-2).SetMax(5), a.Config)
+	require.Equal(t, (&data.FieldConfig{Unit: "ms"}).SetMin(-2).SetMax(5), b.Config)
+	require.Equal(t, (&data.FieldConfig{}).SetMin(-2).SetMax(5), empty.Config)
+	require.Nil(t, name.Config)
+}
+
+func TestFrames(t *testing.T) {
+	times := []time.Time{time.Unix(0, 0), time.Unix(10, 0)}
+	wide := data.NewFrame("wide",
+		data.NewField("time", nil, times),
+		data.NewField("cpu", data.Labels{"host": "a"}, []float64{1, 3}).SetConfig(&data.FieldConfig{Unit: "percent"}),
+		data.NewField("cpu", data.Labels{"host": "b"}, []*float64{ptr(2.0), nil}),
+	)
+	wide.RefID = "A"
+	long := data.NewFrame("long",
+		data.NewField("time", nil, []time.Time{times[0], times[0], times[1], times[1]}),
+		data.NewField("mem", nil, []int64{10, 20, 30, 40}),
+		data.NewField("host", nil, []string{"a", "b", "a", "b"}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesLong})
+
+	reduced, err := reduce.Frames(data.Frames{wide, long}, reduce.LastNotNull)
+	require.NoError(t, err)
+
+	expected := data.NewFrame("wide",
+		data.NewField("cpu", data.Labels{"host": "a"}, []*float64{ptr(3.0)}).SetConfig(&data.FieldConfig{Unit: "percent"}),
+		data.NewField("cpu", data.Labels{"host": "b"}, []*float64{ptr(2.0)}),
+		data.NewField("mem", data.Labels{"host": "a"}, []*float64{ptr(30.0)}),
+		data.NewField("mem", data.Labels{"host": "b"}, []*float64{ptr(40.0)}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeNumericWide, TypeVersion: data.FrameTypeVersion{0, 1}})
+	expected.RefID = "A"
+	if diff := cmp.Diff(expected, reduced, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	// the config of the input frame is not shared with the reduced frame
+	reduced.Fields[0].Config.Unit = "short"
+	require.Equal(t, "percent", wide.Fields[1].Config.Unit)
+
+	// the long frame is not changed by its conversion to a wide frame
+	require.Equal(t, &data.FrameMeta{Type: data.FrameTypeTimeSeriesLong}, long.Meta)
+}
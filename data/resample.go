@@ -0,0 +1,384 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ResampleMode is how Resample computes the value of a series at a time of the grid.
+type ResampleMode int
+
+const (
+	// ResampleModeLinear interpolates linearly between the points before and after the time.
+	// There is no value before the first point or after the last point of the series.
+	ResampleModeLinear ResampleMode = iota
+
+	// ResampleModeStep uses the value of the last point at or before the time, like a step function.
+	// There is no value before the first point of the series.
+	ResampleModeStep
+
+	// ResampleModeNearest uses the value of the point closest to the time, or of the earlier point
+	// if two points are as close.
+	ResampleModeNearest
+)
+
+func (m ResampleMode) String() string {
+	switch m {
+	case ResampleModeLinear:
+		return "linear"
+	case ResampleModeStep:
+		return "step"
+	case ResampleModeNearest:
+		return "nearest"
+	}
+	return "unknown"
+}
+
+// ResampleAggregation is how Resample combines the points of a step when downsampling.
+type ResampleAggregation int
+
+const (
+	// ResampleAggregationNone does not aggregate: every time of the grid uses the ResampleMode.
+	ResampleAggregationNone ResampleAggregation = iota
+	// ResampleAggregationMean is the mean of the values of the step.
+	ResampleAggregationMean
+	// ResampleAggregationSum is the sum of the values of the step.
+	ResampleAggregationSum
+	// ResampleAggregationMin is the smallest value of the step.
+	ResampleAggregationMin
+	// ResampleAggregationMax is the largest value of the step.
+	ResampleAggregationMax
+	// ResampleAggregationFirst is the value of the first point of the step.
+	ResampleAggregationFirst
+	// ResampleAggregationLast is the value of the last point of the step.
+	ResampleAggregationLast
+	// ResampleAggregationCount is the number of points of the step.
+	ResampleAggregationCount
+)
+
+func (a ResampleAggregation) String() string {
+	switch a {
+	case ResampleAggregationNone:
+		return "none"
+	case ResampleAggregationMean:
+		return "mean"
+	case ResampleAggregationSum:
+		return "sum"
+	case ResampleAggregationMin:
+		return "min"
+	case ResampleAggregationMax:
+		return "max"
+	case ResampleAggregationFirst:
+		return "first"
+	case ResampleAggregationLast:
+		return "last"
+	case ResampleAggregationCount:
+		return "count"
+	}
+	return "unknown"
+}
+
+// ResampleOptions configure Resample and ResampleFrames.
+type ResampleOptions struct {
+	// From and To are the time range of the grid, usually the TimeRange of the query.
+	// The grid has a time at each multiple of Step from From to To, both inclusive.
+	From time.Time
+	To   time.Time
+
+	// Step is the interval between the times of the grid. It must be positive.
+	Step time.Duration
+
+	// Mode is how the value at a time of the grid is computed from the points around it.
+	// The default is ResampleModeLinear.
+	Mode ResampleMode
+
+	// Aggregation, when set, downsamples: the value at a time of the grid that has points in its step,
+	// after the previous time of the grid and up to and including the time, is the aggregate of these
+	// points. The other times of the grid use Mode.
+	Aggregation ResampleAggregation
+
+	// FillMissing is how times of the grid without a value are filled. When nil, they are null.
+	FillMissing *FillMissing
+
+	// MaxDataPoints is the maximum number of times of the grid, usually the MaxDataPoints of the query.
+	// When it is 0 or less, DefaultResampleMaxDataPoints is used.
+	MaxDataPoints int64
+}
+
+// DefaultResampleMaxDataPoints is the maximum number of times of the grid when
+// ResampleOptions.MaxDataPoints is not set. It is enough for a day at a step of one second.
+const DefaultResampleMaxDataPoints = 100000
+
+// resamplePoint is a value that resampling interpolates or aggregates, and its time in Unix nanoseconds.
+type resamplePoint struct {
+	t int64
+	v float64
+}
+
+// Resample returns a new Frame with the series of the wide or long time series frame at the times
+// of a fixed grid, computed according to opts. It is a more general alternative to
+// sqlutil.ResampleWideFrame, which only fills missing rows of wide frames.
+//
+// Value Fields must be numeric and become nullable float64 Fields, keeping their Name, Labels and
+// Config. Null and NaN values are ignored. The time Field must be sorted and have no null values.
+// A long frame has a row for each time of the grid and each series, in the order of the first row
+// of each series in frame.
+func Resample(frame *Frame, opts ResampleOptions) (*Frame, error) {
+	grid, err := resampleGrid(opts)
+	if err != nil {
+		return nil, err
+	}
+	return resampleFrame(frame, grid, opts)
+}
+
+// ResampleFrames resamples each frame with Resample onto the same grid. This aligns frames with
+// different native steps, so that wide results can be joined row by row, for example with JoinFrames.
+func ResampleFrames(opts ResampleOptions, frames ...*Frame) (Frames, error) {
+	grid, err := resampleGrid(opts)
+	if err != nil {
+		return nil, err
+	}
+	out := make(Frames, 0, len(frames))
+	for i, frame := range frames {
+		resampled, err := resampleFrame(frame, grid, opts)
+		if err != nil {
+			return nil, fmt.Errorf("frame %d (%q): %w", i, frame.Name, err)
+		}
+		out = append(out, resampled)
+	}
+	return out, nil
+}
+
+// resampleGrid returns the times of the grid as Unix nanoseconds.
+func resampleGrid(opts ResampleOptions) ([]int64, error) {
+	if opts.Step <= 0 {
+		return nil, fmt.Errorf("invalid resample step %s, must be positive", opts.Step)
+	}
+	if opts.Mode.String() == "unknown" {
+		return nil, fmt.Errorf("invalid resample mode %d", opts.Mode)
+	}
+	if opts.Aggregation.String() == "unknown" {
+		return nil, fmt.Errorf("invalid resample aggregation %d", opts.Aggregation)
+	}
+	if opts.To.Before(opts.From) {
+		return nil, errors.New("invalid resample time range, from is after to")
+	}
+	step := int64(opts.Step)
+	start, end := opts.From.UnixNano(), opts.To.UnixNano()
+	if rem := start % step; rem > 0 {
+		start += step - rem
+	} else if rem < 0 {
+		start -= rem
+	}
+	if start > end {
+		return nil, nil
+	}
+
+	maxDataPoints := opts.MaxDataPoints
+	if maxDataPoints <= 0 {
+		maxDataPoints = DefaultResampleMaxDataPoints
+	}
+	gridLen := (end-start)/step + 1
+	if gridLen > maxDataPoints {
+		return nil, fmt.Errorf("resample grid of %d points with a step of %s exceeds the maximum of %d points", gridLen, opts.Step, maxDataPoints)
+	}
+	grid := make([]int64, gridLen)
+	for i := range grid {
+		grid[i] = start + int64(i)*step
+	}
+	return grid, nil
+}
+
+func resampleFrame(frame *Frame, grid []int64, opts ResampleOptions) (*Frame, error) {
+	schema := frame.TimeSeriesSchema()
+	if schema.Type == TimeSeriesTypeNot {
+		return nil, fmt.Errorf("frame %q is not a time series", frame.Name)
+	}
+	for _, idx := range schema.ValueIndices {
+		field := frame.Fields[idx]
+		if !field.Type().Numeric() {
+			return nil, fmt.Errorf("field %q of type %s is not numeric", field.Name, field.Type())
+		}
+	}
+	times, err := schema.UnixNanoTimes(frame)
+	if err != nil {
+		return nil, err
+	}
+	series, err := schema.SeriesRows(frame)
+	if err != nil {
+		return nil, err
+	}
+
+	outLen := len(grid) * len(series)
+	out := frame.CopyWithoutFields()
+	isValue := make(map[int]bool, len(schema.ValueIndices))
+	for _, idx := range schema.ValueIndices {
+		isValue[idx] = true
+	}
+	for fieldIdx, field := range frame.Fields {
+		var result *Field
+		switch {
+		case fieldIdx == schema.TimeIndex:
+			result = NewFieldFromFieldType(FieldTypeTime, outLen)
+			for gridIdx, t := range grid {
+				for seriesIdx := range series {
+					result.Set(gridIdx*len(series)+seriesIdx, time.Unix(0, t).In(opts.From.Location()))
+				}
+			}
+		case isValue[fieldIdx]:
+			result = NewFieldFromFieldType(FieldTypeNullableFloat64, outLen)
+			for seriesIdx, rows := range series {
+				if err := resampleSeries(field, result, rows, times, grid, seriesIdx, len(series), opts); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			// a factor Field: each row gets the factor value of its series
+			result = NewFieldFromFieldType(field.Type(), outLen)
+			for seriesIdx, rows := range series {
+				v, ok := field.ConcreteAt(rows[0])
+				if !ok {
+					continue
+				}
+				for gridIdx := range grid {
+					result.SetConcrete(gridIdx*len(series)+seriesIdx, v)
+				}
+			}
+		}
+		result.Name = field.Name
+		if field.Labels != nil {
+			result.Labels = field.Labels.Copy()
+		}
+		result.Config = field.Config
+		out.Fields = append(out.Fields, result)
+	}
+	return out, nil
+}
+
+// resampleSeries sets the values of a series in result, whose row for the time at gridIdx
+// is gridIdx*seriesLen+seriesIdx.
+func resampleSeries(field, result *Field, rows []int, times, grid []int64, seriesIdx, seriesLen int, opts ResampleOptions) error {
+	points := make([]resamplePoint, 0, len(rows))
+	for _, rowIdx := range rows {
+		v, err := field.NullableFloatAt(rowIdx)
+		if err != nil {
+			return err
+		}
+		if v == nil || math.IsNaN(*v) {
+			continue
+		}
+		points = append(points, resamplePoint{t: times[rowIdx], v: *v})
+	}
+
+	// points[:next] are the points at or before the time of the grid, points[stepStart:next] those of its step
+	next, stepStart := 0, 0
+	for gridIdx, t := range grid {
+		for next < len(points) && points[next].t <= t {
+			next++
+		}
+		for stepStart < next && points[stepStart].t <= t-int64(opts.Step) {
+			stepStart++
+		}
+
+		var v float64
+		var ok bool
+		if opts.Aggregation != ResampleAggregationNone && stepStart < next {
+			v, ok = resampleAggregate(points[stepStart:next], opts.Aggregation)
+		} else {
+			v, ok = resampleValue(points, next, t, opts.Mode)
+		}
+		if !ok && opts.FillMissing != nil {
+			switch opts.FillMissing.Mode {
+			case FillModeValue:
+				v, ok = opts.FillMissing.Value, true
+			case FillModePrevious:
+				if next > 0 {
+					v, ok = points[next-1].v, true
+				}
+			case FillModeNull:
+			}
+		}
+		if ok {
+			result.SetConcrete(gridIdx*seriesLen+seriesIdx, v)
+		}
+	}
+	return nil
+}
+
+// resampleValue returns the value at t of the points according to mode, where points[:next] are
+// the points at or before t.
+func resampleValue(points []resamplePoint, next int, t int64, mode ResampleMode) (float64, bool) {
+	var prev, after *resamplePoint
+	if next > 0 {
+		prev = &points[next-1]
+	}
+	if next < len(points) {
+		after = &points[next]
+	}
+
+	switch mode {
+	case ResampleModeLinear:
+		if prev == nil {
+			return 0, false
+		}
+		if prev.t == t {
+			return prev.v, true
+		}
+		if after == nil {
+			return 0, false
+		}
+		ratio := float64(t-prev.t) / float64(after.t-prev.t)
+		return prev.v + (after.v-prev.v)*ratio, true
+	case ResampleModeStep:
+		if prev == nil {
+			return 0, false
+		}
+		return prev.v, true
+	case ResampleModeNearest:
+		switch {
+		case prev == nil && after == nil:
+			return 0, false
+		case after == nil:
+			return prev.v, true
+		case prev == nil:
+			return after.v, true
+		case after.t-t < t-prev.t:
+			return after.v, true
+		default:
+			return prev.v, true
+		}
+	}
+	return 0, false
+}
+
+// resampleAggregate returns the aggregate of the points, of which there is at least one.
+func resampleAggregate(points []resamplePoint, aggregation ResampleAggregation) (float64, bool) {
+	switch aggregation {
+	case ResampleAggregationFirst:
+		return points[0].v, true
+	case ResampleAggregationLast:
+		return points[len(points)-1].v, true
+	case ResampleAggregationCount:
+		return float64(len(points)), true
+	}
+
+	sum, minV, maxV := 0.0, points[0].v, points[0].v
+	for _, p := range points {
+		sum += p.v
+		minV = math.Min(minV, p.v)
+		maxV = math.Max(maxV, p.v)
+	}
+	switch aggregation {
+	case ResampleAggregationMean:
+		return sum / float64(len(points)), true
+	case ResampleAggregationSum:
+		return sum, true
+	case ResampleAggregationMin:
+		return minV, true
+	case ResampleAggregationMax:
+		return maxV, true
+	}
+	return 0, false
+}
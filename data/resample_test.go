@@ -0,0 +1,147 @@
+package data_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+func TestResample(t *testing.T) {
+	t0 := time.Date(2020, 1, 2, 3, 4, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return t0.Add(time.Duration(seconds) * time.Second) }
+
+	wide := data.NewFrame("wide",
+		data.NewField("time", nil, []time.Time{at(10), at(30), at(40)}),
+		data.NewField("value", data.Labels{"host": "a"}, []*int64{int64Ptr(10), int64Ptr(30), nil}),
+	)
+
+	tests := []struct {
+		name     string
+		frame    *data.Frame
+		opts     data.ResampleOptions
+		expected *data.Frame
+	}{
+		{
+			name:  "linear",
+			frame: wide,
+			opts:  data.ResampleOptions{From: at(0), To: at(40), Step: 10 * time.Second},
+			expected: data.NewFrame("wide",
+				data.NewField("time", nil, []time.Time{at(0), at(10), at(20), at(30), at(40)}),
+				data.NewField("value", data.Labels{"host": "a"}, []*float64{nil, float64Ptr(10), float64Ptr(20), float64Ptr(30), nil}),
+			),
+		},
+		{
+			name:  "step with grid aligned to the step",
+			frame: wide,
+			opts:  data.ResampleOptions{From: at(5), To: at(45), Step: 10 * time.Second, Mode: data.ResampleModeStep},
+			expected: data.NewFrame("wide",
+				data.NewField("time", nil, []time.Time{at(10), at(20), at(30), at(40)}),
+				data.NewField("value", data.Labels{"host": "a"}, []*float64{float64Ptr(10), float64Ptr(10), float64Ptr(30), float64Ptr(30)}),
+			),
+		},
+		{
+			name:  "nearest",
+			frame: wide,
+			opts:  data.ResampleOptions{From: at(0), To: at(35), Step: 5 * time.Second, Mode: data.ResampleModeNearest},
+			expected: data.NewFrame("wide",
+				data.NewField("time", nil, []time.Time{at(0), at(5), at(10), at(15), at(20), at(25), at(30), at(35)}),
+				data.NewField("value", data.Labels{"host": "a"}, []*float64{
+					float64Ptr(10), float64Ptr(10), float64Ptr(10), float64Ptr(10), float64Ptr(10), float64Ptr(30), float64Ptr(30), float64Ptr(30),
+				}),
+			),
+		},
+		{
+			name:  "fill missing with a value",
+			frame: wide,
+			opts: data.ResampleOptions{From: at(0), To: at(40), Step: 20 * time.Second,
+				FillMissing: &data.FillMissing{Mode: data.FillModeValue, Value: -1}},
+			expected: data.NewFrame("wide",
+				data.NewField("time", nil, []time.Time{at(0), at(20), at(40)}),
+				data.NewField("value", data.Labels{"host": "a"}, []*float64{float64Ptr(-1), float64Ptr(20), float64Ptr(-1)}),
+			),
+		},
+		{
+			name: "aggregation on downsample",
+			frame: data.NewFrame("wide",
+				data.NewField("time", nil, []time.Time{at(1), at(2), at(3), at(4), at(11)}),
+				data.NewField("value", nil, []float64{1, 2, 3, 4, 10}),
+			),
+			opts: data.ResampleOptions{From: at(0), To: at(15), Step: 5 * time.Second,
+				Mode: data.ResampleModeStep, Aggregation: data.ResampleAggregationMean},
+			expected: data.NewFrame("wide",
+				data.NewField("time", nil, []time.Time{at(0), at(5), at(10), at(15)}),
+				data.NewField("value", nil, []*float64{nil, float64Ptr(2.5), float64Ptr(4), float64Ptr(10)}),
+			),
+		},
+		{
+			name: "long",
+			frame: data.NewFrame("long",
+				data.NewField("time", nil, []time.Time{at(0), at(0), at(20), at(20)}),
+				data.NewField("host", nil, []string{"a", "b", "b", "a"}),
+				data.NewField("value", nil, []float64{0, 10, 30, 20}),
+			),
+			opts: data.ResampleOptions{From: at(0), To: at(20), Step: 10 * time.Second},
+			expected: data.NewFrame("long",
+				data.NewField("time", nil, []time.Time{at(0), at(0), at(10), at(10), at(20), at(20)}),
+				data.NewField("host", nil, []string{"a", "b", "a", "b", "a", "b"}),
+				data.NewField("value", nil, []*float64{float64Ptr(0), float64Ptr(10), float64Ptr(10), float64Ptr(20), float64Ptr(20), float64Ptr(30)}),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := data.Resample(tt.frame, tt.opts)
+			require.NoError(t, err)
+			if diff := cmp.Diff(tt.expected, got, data.FrameTestCompareOptions()...); diff != "" {
+				t.Errorf("Result mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := data.Resample(wide, data.ResampleOptions{From: at(0), To: at(10)})
+		require.Error(t, err)
+		_, err = data.Resample(wide, data.ResampleOptions{From: at(10), To: at(0), Step: time.Second})
+		require.Error(t, err)
+		_, err = data.Resample(data.NewFrame("", data.NewField("value", nil, []float64{1})),
+			data.ResampleOptions{From: at(0), To: at(10), Step: time.Second})
+		require.Error(t, err)
+		_, err = data.Resample(wide, data.ResampleOptions{From: at(0), To: at(0).Add(30 * 24 * time.Hour), Step: time.Millisecond})
+		require.Error(t, err)
+		_, err = data.Resample(wide, data.ResampleOptions{From: at(0), To: at(40), Step: 10 * time.Second, MaxDataPoints: 4})
+		require.Error(t, err)
+	})
+}
+
+func TestResampleFrames(t *testing.T) {
+	t0 := time.Date(2020, 1, 2, 3, 4, 0, 0, time.UTC)
+	at := func(seconds int) time.Time { return t0.Add(time.Duration(seconds) * time.Second) }
+
+	fast := data.NewFrame("fast",
+		data.NewField("time", nil, []time.Time{at(0), at(5), at(10), at(15), at(20)}),
+		data.NewField("value", nil, []float64{0, 5, 10, 15, 20}),
+	)
+	slow := data.NewFrame("slow",
+		data.NewField("time", nil, []time.Time{at(3), at(23)}),
+		data.NewField("value", nil, []float64{3, 23}),
+	)
+
+	frames, err := data.ResampleFrames(data.ResampleOptions{From: at(0), To: at(20), Step: 10 * time.Second}, fast, slow)
+	require.NoError(t, err)
+	require.Len(t, frames, 2)
+
+	joined, err := data.JoinFrames(data.JoinOptions{Type: data.JoinTypeInner}, frames...)
+	require.NoError(t, err)
+	expected := data.NewFrame("fast",
+		data.NewField("time", nil, []time.Time{at(0), at(10), at(20)}),
+		data.NewField("value", nil, []*float64{float64Ptr(0), float64Ptr(10), float64Ptr(20)}),
+		data.NewField("value", data.Labels{"frame": "slow"}, []*float64{nil, float64Ptr(10), float64Ptr(20)}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesWide, TypeVersion: data.FrameTypeVersion{0, 1}})
+	if diff := cmp.Diff(expected, joined, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"regexp"
 	"strings"
@@ -183,8 +184,24 @@ func DefaultConverterFunc(t reflect.Type) func(in interface{}) (interface{}, err
 	}
 }
 
+// decimalScanTypes are the scan types of exact decimal values. They are scanned as strings and placed into the
+// dataframe as data.Decimal values, so they keep their precision.
+var decimalScanTypes = map[reflect.Type]bool{
+	reflect.TypeOf(data.Decimal{}):  true,
+	reflect.TypeOf(&data.Decimal{}): true,
+	reflect.TypeOf(big.Int{}):       true,
+	reflect.TypeOf(&big.Int{}):      true,
+	reflect.TypeOf(big.Float{}):     true,
+	reflect.TypeOf(&big.Float{}):    true,
+}
+
 // NewDefaultConverter creates a Converter that assumes that the value is scannable into a String, and placed into the dataframe as a nullable string.
+// Exact decimal scan types, such as data.Decimal or big.Int, are placed into the dataframe as data.Decimal values.
 func NewDefaultConverter(name string, nullable bool, t reflect.Type) Converter {
+	if decimalScanTypes[t] {
+		return newDecimalConverter(name, nullable)
+	}
+
 	slice := reflect.MakeSlice(reflect.SliceOf(t), 0, 0).Interface()
 	kind := fmt.Sprint(t)
 	if !strings.HasPrefix(kind, "sql.Null") && !data.ValidFieldType(slice) {
@@ -234,6 +251,29 @@ func NewDefaultConverter(name string, nullable bool, t reflect.Type) Converter {
 	}
 }
 
+func newDecimalConverter(name string, nullable bool) Converter {
+	if nullable {
+		return NullExactDecimalConverter
+	}
+	return Converter{
+		Name:          fmt.Sprintf("Decimal converter for %s", name),
+		InputScanType: reflect.TypeOf(sql.NullString{}),
+		InputTypeName: name,
+		FrameConverter: FrameConverter{
+			FieldType: data.FieldTypeDecimal,
+			ConverterFunc: func(in interface{}) (interface{}, error) {
+				v := in.(*sql.NullString)
+
+				if !v.Valid {
+					return nil, fmt.Errorf("unexpected NULL value in the non-nullable decimal column %s", name)
+				}
+
+				return data.ParseDecimal(v.String)
+			},
+		},
+	}
+}
+
 var (
 	// NullStringConverter creates a *string using the scan type of `sql.NullString`
 	NullStringConverter = Converter{
@@ -335,6 +375,51 @@ var (
 		},
 	}
 
+	// NullExactDecimalConverter creates a *data.Decimal using the scan type of `sql.NullString`, so
+	// DECIMAL and NUMERIC values keep their precision instead of being converted to float64.
+	// To use it for all decimal columns, set its InputTypeMatcher to IsDecimalDatabaseType.
+	NullExactDecimalConverter = Converter{
+		Name:          "NULLABLE exact decimal converter",
+		InputScanType: reflect.TypeOf(sql.NullString{}),
+		InputTypeName: "DECIMAL",
+		FrameConverter: FrameConverter{
+			FieldType: data.FieldTypeNullableDecimal,
+			ConverterFunc: func(n interface{}) (interface{}, error) {
+				v := n.(*sql.NullString)
+
+				if !v.Valid {
+					return (*data.Decimal)(nil), nil
+				}
+
+				d, err := data.ParseDecimal(v.String)
+				if err != nil {
+					return nil, err
+				}
+				return &d, nil
+			},
+		},
+	}
+
+	// NullDurationConverter creates a *time.Duration using the scan type of `sql.Null[time.Duration]`
+	NullDurationConverter = Converter{
+		Name:          "NULLABLE time.Duration converter",
+		InputScanType: reflect.TypeOf(sql.Null[time.Duration]{}),
+		InputTypeName: "INTERVAL",
+		FrameConverter: FrameConverter{
+			FieldType: data.FieldTypeNullableDuration,
+			ConverterFunc: func(n interface{}) (interface{}, error) {
+				v := n.(*sql.Null[time.Duration])
+
+				if !v.Valid {
+					return (*time.Duration)(nil), nil
+				}
+
+				d := v.V
+				return &d, nil
+			},
+		},
+	}
+
 	// NullTimeConverter creates a *time.time using the scan type of `sql.NullTime`
 	NullTimeConverter = Converter{
 		Name:          "NULLABLE time.Time converter",
@@ -404,6 +489,7 @@ var NullConverters = map[reflect.Type]Converter{
 	reflect.TypeOf(int32(0)):          NullInt32Converter,
 	reflect.TypeOf(""):                NullStringConverter,
 	reflect.TypeOf(time.Time{}):       NullTimeConverter,
+	reflect.TypeOf(time.Duration(0)):  NullDurationConverter,
 	reflect.TypeOf(false):             NullBoolConverter,
 	reflect.TypeOf(sql.NullFloat64{}): NullDecimalConverter,
 	reflect.TypeOf(sql.NullTime{}):    NullTimeConverter,
@@ -415,6 +501,22 @@ var NullConverters = map[reflect.Type]Converter{
 	reflect.TypeOf(sql.NullString{}):  NullStringConverter,
 }
 
+// IsDecimalDatabaseType returns true for the database type names of exact decimal columns, such as
+// DECIMAL, NUMERIC(10, 2) or the ClickHouse types Decimal64(4) and Nullable(Decimal(18, 4)).
+// It can be used as the InputTypeMatcher of a Converter, such as NullExactDecimalConverter.
+func IsDecimalDatabaseType(dbType string) bool {
+	t := strings.ToUpper(strings.TrimSpace(dbType))
+	t = strings.TrimPrefix(t, "NULLABLE(")
+	if i := strings.IndexAny(t, "( "); i >= 0 {
+		t = t[:i]
+	}
+	switch t {
+	case "DEC", "DECIMAL", "NUMERIC", "DECIMAL32", "DECIMAL64", "DECIMAL128", "DECIMAL256":
+		return true
+	}
+	return false
+}
+
 // IntOrFloatToNullableFloat64 returns an error if the input is not a variation of int or float.
 var IntOrFloatToNullableFloat64 = data.FieldConverter{
 	OutputFieldType: data.FieldTypeNullableFloat64,
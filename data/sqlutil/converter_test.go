@@ -3,6 +3,7 @@ package sqlutil_test
 import (
 	"database/sql"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strings"
 	"testing"
@@ -187,3 +188,33 @@ func TestDefaultConverter(t *testing.T) {
 		})
 	}
 }
+
+func TestNullExactDecimalConverter(t *testing.T) {
+	// the name of a scan type does not make a decimal
+	type Decimal struct{ value string }
+	c := sqlutil.NewDefaultConverter("value", false, reflect.TypeOf(Decimal{}))
+	assert.Equal(t, data.FieldTypeNullableString, c.FrameConverter.FieldType)
+
+	// exact decimal scan types do
+	c = sqlutil.NewDefaultConverter("value", true, reflect.TypeOf(&big.Float{}))
+	assert.Equal(t, data.FieldTypeNullableDecimal, c.FrameConverter.FieldType)
+	c = sqlutil.NewDefaultConverter("value", false, reflect.TypeOf(data.Decimal{}))
+	assert.Equal(t, data.FieldTypeDecimal, c.FrameConverter.FieldType)
+	value, err := c.FrameConverter.ConverterFunc(&sql.NullString{String: "12345678901234567890.0123456789", Valid: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "12345678901234567890.0123456789", value.(data.Decimal).String())
+	_, err = c.FrameConverter.ConverterFunc(&sql.NullString{})
+	assert.Error(t, err)
+
+	assert.True(t, sqlutil.IsDecimalDatabaseType("NUMERIC(10, 2)"))
+	assert.True(t, sqlutil.IsDecimalDatabaseType("Nullable(Decimal64(4))"))
+	assert.False(t, sqlutil.IsDecimalDatabaseType("DECIMALS"))
+	assert.False(t, sqlutil.IsDecimalDatabaseType("FLOAT"))
+
+	value, err = sqlutil.NullExactDecimalConverter.FrameConverter.ConverterFunc(&sql.NullString{String: "-10.25", Valid: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "-10.25", value.(*data.Decimal).String())
+
+	_, err = sqlutil.NullExactDecimalConverter.FrameConverter.ConverterFunc(&sql.NullString{String: "abc", Valid: true})
+	assert.Error(t, err)
+}
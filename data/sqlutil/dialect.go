@@ -0,0 +1,176 @@
+package sqlutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect is the flavour of SQL of a database. The default macros use the Dialect of the Query
+// to generate SQL that the database understands.
+type Dialect interface {
+	// Name returns the name of the dialect, such as "postgres".
+	Name() string
+
+	// QuoteIdentifier quotes an identifier, such as a column or table name, escaping quotes in it.
+	QuoteIdentifier(name string) string
+
+	// TimeLiteral returns a literal of the time t, with the wall clock time of its location.
+	TimeLiteral(t time.Time) string
+
+	// UnixEpoch returns an expression converting the time expression expr to seconds since the Unix epoch.
+	UnixEpoch(expr string) string
+
+	// TimeBucket returns an expression rounding the expression of seconds since the Unix epoch epoch
+	// down to a multiple of interval.
+	TimeBucket(epoch string, interval time.Duration) string
+
+	// Limit returns the clause limiting the rows returned by a query to n, to add at the end of the query.
+	Limit(n int64) string
+}
+
+var (
+	// PostgresDialect is the Dialect of PostgreSQL and compatible databases such as TimescaleDB.
+	PostgresDialect Dialect = postgresDialect{}
+
+	// MySQLDialect is the Dialect of MySQL and MariaDB.
+	MySQLDialect Dialect = mysqlDialect{}
+
+	// MSSQLDialect is the Dialect of Microsoft SQL Server. Its Limit clause requires an ORDER BY clause.
+	MSSQLDialect Dialect = mssqlDialect{}
+
+	// SQLiteDialect is the Dialect of SQLite, with times stored as text.
+	SQLiteDialect Dialect = sqliteDialect{}
+
+	// ClickHouseDialect is the Dialect of ClickHouse.
+	ClickHouseDialect Dialect = clickhouseDialect{}
+
+	// genericDialect is used by the default macros when a Query has no Dialect.
+	genericDialect Dialect = ansiDialect{}
+)
+
+// ansiDialect is standard SQL. Time literals are RFC 3339 strings.
+type ansiDialect struct{}
+
+func (ansiDialect) Name() string { return "ansi" }
+
+func (ansiDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, `"`, `"`) }
+
+func (ansiDialect) TimeLiteral(t time.Time) string {
+	return "'" + t.Format(time.RFC3339) + "'"
+}
+
+func (ansiDialect) UnixEpoch(expr string) string {
+	return fmt.Sprintf("EXTRACT(EPOCH FROM %s)", expr)
+}
+
+func (ansiDialect) TimeBucket(epoch string, interval time.Duration) string {
+	s := intervalSeconds(interval)
+	return fmt.Sprintf("FLOOR((%s)/%s)*%s", epoch, s, s)
+}
+
+func (ansiDialect) Limit(n int64) string { return "LIMIT " + strconv.FormatInt(n, 10) }
+
+type postgresDialect struct{ ansiDialect }
+
+func (postgresDialect) Name() string { return "postgres" }
+
+func (postgresDialect) TimeLiteral(t time.Time) string {
+	return "'" + t.Format(time.RFC3339Nano) + "'"
+}
+
+func (postgresDialect) UnixEpoch(expr string) string {
+	return fmt.Sprintf("extract(epoch from %s)", expr)
+}
+
+func (postgresDialect) TimeBucket(epoch string, interval time.Duration) string {
+	s := intervalSeconds(interval)
+	return fmt.Sprintf("floor((%s)/%s)*%s", epoch, s, s)
+}
+
+type mysqlDialect struct{ ansiDialect }
+
+func (mysqlDialect) Name() string { return "mysql" }
+
+func (mysqlDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, "`", "`") }
+
+func (mysqlDialect) TimeLiteral(t time.Time) string {
+	return "'" + t.Format("2006-01-02 15:04:05.999999") + "'"
+}
+
+func (mysqlDialect) UnixEpoch(expr string) string {
+	return fmt.Sprintf("UNIX_TIMESTAMP(%s)", expr)
+}
+
+func (mysqlDialect) TimeBucket(epoch string, interval time.Duration) string {
+	s := intervalSeconds(interval)
+	return fmt.Sprintf("(%s) DIV %s * %s", epoch, s, s)
+}
+
+type mssqlDialect struct{ ansiDialect }
+
+func (mssqlDialect) Name() string { return "mssql" }
+
+func (mssqlDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, "[", "]") }
+
+func (mssqlDialect) TimeLiteral(t time.Time) string {
+	return "'" + t.Format("2006-01-02T15:04:05.999") + "'"
+}
+
+func (mssqlDialect) UnixEpoch(expr string) string {
+	return fmt.Sprintf("DATEDIFF_BIG(second, '1970-01-01', %s)", expr)
+}
+
+func (mssqlDialect) Limit(n int64) string {
+	return fmt.Sprintf("OFFSET 0 ROWS FETCH NEXT %d ROWS ONLY", n)
+}
+
+type sqliteDialect struct{ ansiDialect }
+
+func (sqliteDialect) Name() string { return "sqlite" }
+
+func (sqliteDialect) TimeLiteral(t time.Time) string {
+	return "'" + t.Format("2006-01-02 15:04:05") + "'"
+}
+
+func (sqliteDialect) UnixEpoch(expr string) string {
+	return fmt.Sprintf("CAST(strftime('%%s', %s) AS INTEGER)", expr)
+}
+
+func (sqliteDialect) TimeBucket(epoch string, interval time.Duration) string {
+	s := intervalSeconds(interval)
+	return fmt.Sprintf("CAST((%s)/%s AS INTEGER)*%s", epoch, s, s)
+}
+
+type clickhouseDialect struct{ ansiDialect }
+
+func (clickhouseDialect) Name() string { return "clickhouse" }
+
+func (clickhouseDialect) QuoteIdentifier(name string) string { return quoteIdentifier(name, "`", "`") }
+
+func (clickhouseDialect) TimeLiteral(t time.Time) string {
+	return fmt.Sprintf("toDateTime('%s', '%s')", t.Format(time.DateTime), t.Location())
+}
+
+func (clickhouseDialect) UnixEpoch(expr string) string {
+	return fmt.Sprintf("toUnixTimestamp(%s)", expr)
+}
+
+func (clickhouseDialect) TimeBucket(epoch string, interval time.Duration) string {
+	s := intervalSeconds(interval)
+	if interval%time.Second != 0 {
+		return fmt.Sprintf("floor((%s)/%s)*%s", epoch, s, s)
+	}
+	return fmt.Sprintf("intDiv(%s, %s)*%s", epoch, s, s)
+}
+
+// quoteIdentifier quotes name between open and closing, doubling the closing quote in name.
+func quoteIdentifier(name, open, closing string) string {
+	return open + strings.ReplaceAll(name, closing, closing+closing) + closing
+}
+
+// intervalSeconds returns interval as a number of seconds.
+func intervalSeconds(interval time.Duration) string {
+	return strconv.FormatFloat(interval.Seconds(), 'f', -1, 64)
+}
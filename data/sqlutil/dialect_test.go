@@ -0,0 +1,39 @@
+package sqlutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data/sqlutil"
+)
+
+func TestDialects(t *testing.T) {
+	ts := time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC)
+
+	tests := []struct {
+		dialect     sqlutil.Dialect
+		name        string
+		quoted      string
+		timeLiteral string
+		limit       string
+	}{
+		{sqlutil.PostgresDialect, "postgres", `"my ""col"""`, "'2006-01-02T15:04:05.123Z'", "LIMIT 10"},
+		{sqlutil.MySQLDialect, "mysql", "`my \"col\"`", "'2006-01-02 15:04:05.123'", "LIMIT 10"},
+		{sqlutil.MSSQLDialect, "mssql", `[my "col"]`, "'2006-01-02T15:04:05.123'", "OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY"},
+		{sqlutil.SQLiteDialect, "sqlite", `"my ""col"""`, "'2006-01-02 15:04:05'", "LIMIT 10"},
+		{sqlutil.ClickHouseDialect, "clickhouse", "`my \"col\"`", "toDateTime('2006-01-02 15:04:05', 'UTC')", "LIMIT 10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.name, tt.dialect.Name())
+			require.Equal(t, tt.quoted, tt.dialect.QuoteIdentifier(`my "col"`))
+			require.Equal(t, tt.timeLiteral, tt.dialect.TimeLiteral(ts))
+			require.Equal(t, tt.limit, tt.dialect.Limit(10))
+		})
+	}
+
+	require.Equal(t, "`a``b`", sqlutil.MySQLDialect.QuoteIdentifier("a`b"))
+	require.Equal(t, "[a]]b]", sqlutil.MSSQLDialect.QuoteIdentifier("a]b"))
+}
@@ -43,80 +43,225 @@ func macroIntervalMS(query *Query, _ []string) (string, error) {
 }
 
 // Default time filter for SQL based on the query time range.
-// It requires one argument, the time column to filter.
+// It requires one argument, the time column to filter, and accepts the time zone of the
+// column as second argument. The times are formatted by the Dialect of the query.
 // Example:
 //
-//	$__timeFilter(time) => "time BETWEEN '2006-01-02T15:04:05Z07:00' AND '2006-01-02T15:04:05Z07:00'"
+//	$__timeFilter(time) => "time >= '2006-01-02T15:04:05Z' AND time <= '2006-01-02T15:04:05Z'"
+//	$__timeFilter(time, 'Europe/Berlin') => "time >= '2006-01-02T16:04:05+01:00' AND time <= '2006-01-02T16:04:05+01:00'"
 func macroTimeFilter(query *Query, args []string) (string, error) {
-	if len(args) != 1 {
-		return "", fmt.Errorf("%w: expected 1 argument, received %d", ErrorBadArgumentCount, len(args))
+	loc, err := macroLocation(args)
+	if err != nil {
+		return "", err
 	}
 
 	var (
 		column = args[0]
-		from   = query.TimeRange.From.UTC().Format(time.RFC3339)
-		to     = query.TimeRange.To.UTC().Format(time.RFC3339)
+		from   = query.dialect().TimeLiteral(query.TimeRange.From.In(loc))
+		to     = query.dialect().TimeLiteral(query.TimeRange.To.In(loc))
 	)
 
-	return fmt.Sprintf("%s >= '%s' AND %s <= '%s'", column, from, column, to), nil
+	return fmt.Sprintf("%s >= %s AND %s <= %s", column, from, column, to), nil
 }
 
 // Default time filter for SQL based on the starting query time range.
-// It requires one argument, the time column to filter.
+// It requires one argument, the time column to filter, and accepts the time zone of the column as second argument.
 // Example:
 //
-//	$__timeFrom(time) => "time > '2006-01-02T15:04:05Z07:00'"
+//	$__timeFrom(time) => "time >= '2006-01-02T15:04:05Z'"
 func macroTimeFrom(query *Query, args []string) (string, error) {
-	if len(args) != 1 {
-		return "", fmt.Errorf("%w: expected 1 argument, received %d", ErrorBadArgumentCount, len(args))
+	loc, err := macroLocation(args)
+	if err != nil {
+		return "", err
 	}
 
-	return fmt.Sprintf("%s >= '%s'", args[0], query.TimeRange.From.UTC().Format(time.RFC3339)), nil
+	return fmt.Sprintf("%s >= %s", args[0], query.dialect().TimeLiteral(query.TimeRange.From.In(loc))), nil
 }
 
 // Default time filter for SQL based on the ending query time range.
-// It requires one argument, the time column to filter.
+// It requires one argument, the time column to filter, and accepts the time zone of the column as second argument.
 // Example:
 //
-//	$__timeTo(time) => "time < '2006-01-02T15:04:05Z07:00'"
+//	$__timeTo(time) => "time <= '2006-01-02T15:04:05Z'"
 func macroTimeTo(query *Query, args []string) (string, error) {
-	if len(args) != 1 {
-		return "", fmt.Errorf("%w: expected 1 argument, received %d", ErrorBadArgumentCount, len(args))
+	loc, err := macroLocation(args)
+	if err != nil {
+		return "", err
 	}
 
-	return fmt.Sprintf("%s <= '%s'", args[0], query.TimeRange.To.UTC().Format(time.RFC3339)), nil
+	return fmt.Sprintf("%s <= %s", args[0], query.dialect().TimeLiteral(query.TimeRange.To.In(loc))), nil
 }
 
-// Default time group for SQL based the given period.
-// This basic example is meant to be customized with more complex periods.
-// It requires two arguments, the column to filter and the period.
+// Default time group for SQL based on the given interval.
+// It requires two arguments, the time column and the interval, such as 5m or $__interval.
+// It returns the start of the interval of each row in seconds since the Unix epoch, computed by
+// the Dialect of the query.
+// Without a Dialect, the legacy periods minute, hour, day, month and year are also accepted.
 // Example:
 //
-//	$__timeGroup(time, month) => "datepart(year, time), datepart(month, time)'"
-func macroTimeGroup(_ *Query, args []string) (string, error) {
+//	$__timeGroup(time, 5m) => "floor((extract(epoch from time))/300)*300" (PostgresDialect)
+//	$__timeGroup(time, month) => "datepart(month, time),datepart(year, time)" (no Dialect)
+func macroTimeGroup(query *Query, args []string) (string, error) {
 	if len(args) != 2 {
-		return "", fmt.Errorf("%w: expected 1 argument, received %d", ErrorBadArgumentCount, len(args))
+		return "", fmt.Errorf("%w: expected 2 arguments, received %d", ErrorBadArgumentCount, len(args))
+	}
+	if query.Dialect == nil {
+		if res, ok := legacyTimeGroup(args[0], args[1]); ok {
+			return res, nil
+		}
 	}
 
+	interval, err := macroIntervalArg(query, args[1])
+	if err != nil {
+		return "", err
+	}
+	d := query.dialect()
+	return d.TimeBucket(d.UnixEpoch(args[0]), interval), nil
+}
+
+// legacyTimeGroup groups by the parts of the column down to period, which is one of
+// minute, hour, day, month and year.
+func legacyTimeGroup(column, period string) (string, bool) {
 	res := ""
-	switch args[1] {
+	switch period {
 	case "minute":
-		res += fmt.Sprintf("datepart(minute, %s),", args[0])
+		res += fmt.Sprintf("datepart(minute, %s),", column)
 		fallthrough
 	case "hour":
-		res += fmt.Sprintf("datepart(hour, %s),", args[0])
+		res += fmt.Sprintf("datepart(hour, %s),", column)
 		fallthrough
 	case "day":
-		res += fmt.Sprintf("datepart(day, %s),", args[0])
+		res += fmt.Sprintf("datepart(day, %s),", column)
 		fallthrough
 	case "month":
-		res += fmt.Sprintf("datepart(month, %s),", args[0])
+		res += fmt.Sprintf("datepart(month, %s),", column)
 		fallthrough
 	case "year":
-		res += fmt.Sprintf("datepart(year, %s)", args[0])
+		res += fmt.Sprintf("datepart(year, %s)", column)
+	default:
+		return "", false
+	}
+
+	return res, true
+}
+
+// Default time group for SQL, aliased as the time column.
+// It takes the same arguments as $__timeGroup.
+// Example:
+//
+//	$__timeGroupAlias(time, 5m) => "floor((extract(epoch from time))/300)*300 AS "time"" (PostgresDialect)
+func macroTimeGroupAlias(query *Query, args []string) (string, error) {
+	res, err := macroTimeGroup(query, args)
+	if err != nil {
+		return "", err
+	}
+	return res + " AS " + query.dialect().QuoteIdentifier("time"), nil
+}
+
+// Default time filter for SQL based on the query time range, for columns of seconds since the Unix epoch.
+// It requires one argument, the column to filter.
+// Example:
+//
+//	$__unixEpochFilter(time) => "time >= 1136214245 AND time <= 1136217845"
+func macroUnixEpochFilter(query *Query, args []string) (string, error) {
+	if len(args) != 1 || args[0] == "" {
+		return "", fmt.Errorf("%w: expected 1 argument, received %d", ErrorBadArgumentCount, len(args))
+	}
+
+	var (
+		column = args[0]
+		from   = query.TimeRange.From.Unix()
+		to     = query.TimeRange.To.Unix()
+	)
+
+	return fmt.Sprintf("%s >= %d AND %s <= %d", column, from, column, to), nil
+}
+
+// Default macro to return the start of the query time range in seconds since the Unix epoch.
+// Example:
+//
+//	$__unixEpochFrom() => "1136214245"
+func macroUnixEpochFrom(query *Query, _ []string) (string, error) {
+	return strconv.FormatInt(query.TimeRange.From.Unix(), 10), nil
+}
+
+// Default macro to return the end of the query time range in seconds since the Unix epoch.
+// Example:
+//
+//	$__unixEpochTo() => "1136217845"
+func macroUnixEpochTo(query *Query, _ []string) (string, error) {
+	return strconv.FormatInt(query.TimeRange.To.Unix(), 10), nil
+}
+
+// Default time group for SQL based on the given interval, for columns of seconds since the Unix epoch.
+// It requires two arguments, the column and the interval, such as 5m or $__interval.
+// Example:
+//
+//	$__unixEpochGroup(time, 5m) => "floor((time)/300)*300" (PostgresDialect)
+func macroUnixEpochGroup(query *Query, args []string) (string, error) {
+	if len(args) != 2 {
+		return "", fmt.Errorf("%w: expected 2 arguments, received %d", ErrorBadArgumentCount, len(args))
+	}
+
+	interval, err := macroIntervalArg(query, args[1])
+	if err != nil {
+		return "", err
+	}
+	return query.dialect().TimeBucket(args[0], interval), nil
+}
+
+// Default time group for SQL, for columns of seconds since the Unix epoch, aliased as the time column.
+// It takes the same arguments as $__unixEpochGroup.
+// Example:
+//
+//	$__unixEpochGroupAlias(time, 5m) => "floor((time)/300)*300 AS "time"" (PostgresDialect)
+func macroUnixEpochGroupAlias(query *Query, args []string) (string, error) {
+	res, err := macroUnixEpochGroup(query, args)
+	if err != nil {
+		return "", err
 	}
+	return res + " AS " + query.dialect().QuoteIdentifier("time"), nil
+}
 
-	return res, nil
+// macroLocation returns the time zone of the optional second argument of a time filter macro, or UTC.
+func macroLocation(args []string) (*time.Location, error) {
+	if len(args) < 1 || len(args) > 2 || args[0] == "" {
+		return nil, fmt.Errorf("%w: expected 1 or 2 arguments, received %d", ErrorBadArgumentCount, len(args))
+	}
+	if len(args) == 1 {
+		return time.UTC, nil
+	}
+	name := unquoteMacroArg(args[1])
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// macroIntervalArg parses the interval argument of a time group macro. The interval of the query is
+// used for $__interval, which is interpolated after the time group macros, and for auto.
+func macroIntervalArg(query *Query, arg string) (time.Duration, error) {
+	arg = unquoteMacroArg(arg)
+	interval := query.Interval
+	if arg != "$__interval" && arg != "auto" {
+		var err error
+		if interval, err = gtime.ParseDuration(arg); err != nil {
+			return 0, fmt.Errorf("invalid interval %q: %w", arg, err)
+		}
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid interval %q, must be positive", arg)
+	}
+	return interval, nil
+}
+
+// unquoteMacroArg removes the single or double quotes around a macro argument.
+func unquoteMacroArg(arg string) string {
+	if len(arg) >= 2 && (arg[0] == '\'' || arg[0] == '"') && arg[len(arg)-1] == arg[0] {
+		return arg[1 : len(arg)-1]
+	}
+	return arg
 }
 
 // Default macro to return the query table name.
@@ -144,6 +289,13 @@ var DefaultMacros = Macros{
 	"timeTo":      macroTimeTo,
 	"table":       macroTable,
 	"column":      macroColumn,
+
+	"timeGroupAlias":      macroTimeGroupAlias,
+	"unixEpochFilter":     macroUnixEpochFilter,
+	"unixEpochFrom":       macroUnixEpochFrom,
+	"unixEpochTo":         macroUnixEpochTo,
+	"unixEpochGroup":      macroUnixEpochGroup,
+	"unixEpochGroupAlias": macroUnixEpochGroupAlias,
 }
 
 type macroMatch struct {
@@ -214,7 +366,8 @@ func parseArgs(argString string) ([]string, int) {
 	return nil, -1
 }
 
-// Interpolate returns an interpolated query string given a backend.DataQuery
+// Interpolate returns an interpolated query string given a backend.DataQuery.
+// The default macros generate SQL in the Dialect of the query.
 func Interpolate(query *Query, macros Macros) (string, error) {
 	mergedMacros := Macros{}
 	maps.Copy(mergedMacros, DefaultMacros) //nolint:govet // inline analyzer cannot inline maps.Copy (type parameter inference)
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
 )
 
 func staticMacro(output string) MacroFunc {
@@ -305,3 +307,128 @@ func TestGetMacroMatches(t *testing.T) {
 		assert.Nil(t, matches)
 	})
 }
+
+func TestInterpolateWithDialect(t *testing.T) {
+	from := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		dialect Dialect
+		input   string
+		output  string
+		wantErr bool
+	}{
+		{
+			name:    "postgres timeFilter",
+			dialect: PostgresDialect,
+			input:   "select * from foo where $__timeFilter(time)",
+			output:  "select * from foo where time >= '2006-01-02T15:04:05Z' AND time <= '2006-01-02T16:04:05Z'",
+		},
+		{
+			name:    "mysql timeFilter with time zone",
+			dialect: MySQLDialect,
+			input:   "select * from foo where $__timeFilter(time, 'Europe/Berlin')",
+			output:  "select * from foo where time >= '2006-01-02 16:04:05' AND time <= '2006-01-02 17:04:05'",
+		},
+		{
+			name:    "mssql timeFrom and timeTo",
+			dialect: MSSQLDialect,
+			input:   "select * from foo where $__timeFrom(time) and $__timeTo(time)",
+			output:  "select * from foo where time >= '2006-01-02T15:04:05' and time <= '2006-01-02T16:04:05'",
+		},
+		{
+			name:    "clickhouse timeFilter",
+			dialect: ClickHouseDialect,
+			input:   "select * from foo where $__timeFilter(time)",
+			output:  "select * from foo where time >= toDateTime('2006-01-02 15:04:05', 'UTC') AND time <= toDateTime('2006-01-02 16:04:05', 'UTC')",
+		},
+		{
+			name:   "no dialect timeFilter with time zone",
+			input:  "select * from foo where $__timeFilter(time, America/New_York)",
+			output: "select * from foo where time >= '2006-01-02T10:04:05-05:00' AND time <= '2006-01-02T11:04:05-05:00'",
+		},
+		{
+			name:    "invalid time zone",
+			dialect: PostgresDialect,
+			input:   "select * from foo where $__timeFilter(time, 'Nowhere/Nothing')",
+			wantErr: true,
+		},
+		{
+			name:    "postgres timeGroupAlias",
+			dialect: PostgresDialect,
+			input:   "select $__timeGroupAlias(ts, 5m), avg(v) from foo group by 1",
+			output:  `select floor((extract(epoch from ts))/300)*300 AS "time", avg(v) from foo group by 1`,
+		},
+		{
+			name:    "mysql timeGroup with $__interval",
+			dialect: MySQLDialect,
+			input:   "select $__timeGroup(ts, $__interval) as t from foo",
+			output:  "select (UNIX_TIMESTAMP(ts)) DIV 600 * 600 as t from foo",
+		},
+		{
+			name:    "mssql timeGroupAlias",
+			dialect: MSSQLDialect,
+			input:   "select $__timeGroupAlias(ts, '1h') from foo",
+			output:  "select FLOOR((DATEDIFF_BIG(second, '1970-01-01', ts))/3600)*3600 AS [time] from foo",
+		},
+		{
+			name:    "sqlite timeGroup",
+			dialect: SQLiteDialect,
+			input:   "select $__timeGroup(ts, 1m) from foo",
+			output:  "select CAST((CAST(strftime('%s', ts) AS INTEGER))/60 AS INTEGER)*60 from foo",
+		},
+		{
+			name:    "clickhouse timeGroupAlias",
+			dialect: ClickHouseDialect,
+			input:   "select $__timeGroupAlias(ts, 1d) from foo",
+			output:  "select intDiv(toUnixTimestamp(ts), 86400)*86400 AS `time` from foo",
+		},
+		{
+			name:   "no dialect timeGroup with interval",
+			input:  "select $__timeGroup(ts, 30s) from foo",
+			output: "select FLOOR((EXTRACT(EPOCH FROM ts))/30)*30 from foo",
+		},
+		{
+			name:    "timeGroup with invalid interval",
+			dialect: PostgresDialect,
+			input:   "select $__timeGroup(ts, minute) from foo",
+			wantErr: true,
+		},
+		{
+			name:    "unixEpochFilter",
+			dialect: PostgresDialect,
+			input:   "select * from foo where $__unixEpochFilter(ts) and ts > $__unixEpochFrom() and ts < $__unixEpochTo()",
+			output:  "select * from foo where ts >= 1136214245 AND ts <= 1136217845 and ts > 1136214245 and ts < 1136217845",
+		},
+		{
+			name:    "unixEpochGroupAlias",
+			dialect: ClickHouseDialect,
+			input:   "select $__unixEpochGroupAlias(ts, 10m), $__unixEpochGroup(ts, 500ms) from foo",
+			output:  "select intDiv(ts, 600)*600 AS `time`, floor((ts)/0.5)*0.5 from foo",
+		},
+		{
+			name:    "unixEpochFilter without column",
+			dialect: PostgresDialect,
+			input:   "select * from foo where $__unixEpochFilter()",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			query := &Query{
+				RawSQL:    tc.input,
+				Interval:  10 * time.Minute,
+				TimeRange: backend.TimeRange{From: from, To: to},
+				Dialect:   tc.dialect,
+			}
+			interpolatedQuery, err := Interpolate(query, nil)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.output, interpolatedQuery)
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/proxy"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/datasource"
+	"github.com/grafana/grafana-plugin-sdk-go/backend/instancemgmt"
+	"github.com/grafana/grafana-plugin-sdk-go/config"
+)
+
+// PoolOpener opens the connection pool of a data source, usually with sql.Open or sql.OpenDB.
+// When the secure socks proxy is enabled for the data source, dialer is the proxy dialer, and the
+// driver must dial its connections with it. Otherwise dialer is nil.
+type PoolOpener func(ctx context.Context, settings backend.DataSourceInstanceSettings, dialer proxy.Dialer) (*sql.DB, error)
+
+// PoolManager manages a connection pool per data source instance with instancemgmt.
+// A pool is replaced when the settings of its data source or the Grafana config change,
+// and the replaced pool is closed.
+type PoolManager struct {
+	im instancemgmt.InstanceManager
+}
+
+// NewPoolManager returns a PoolManager opening pools with opener.
+// The sql.DBStats of the open pools are exported as Prometheus metrics, with the labels datasource_uid
+// and org_id.
+func NewPoolManager(opener PoolOpener) *PoolManager {
+	if opener == nil {
+		panic("opener cannot be nil")
+	}
+	registerPoolStats()
+	ip := datasource.NewInstanceProvider(func(_ context.Context, settings backend.DataSourceInstanceSettings) (instancemgmt.Instance, error) {
+		return &Pool{settings: settings, opener: opener}, nil
+	})
+	return &PoolManager{
+		im: instancemgmt.NewInstanceManagerWrapper(poolInstanceProvider{ip}),
+	}
+}
+
+// poolInstanceProvider is the instancemgmt.InstanceProvider of a PoolManager. It sets the org of
+// the data source on new pools, which the instance settings do not have.
+type poolInstanceProvider struct {
+	instancemgmt.InstanceProvider
+}
+
+func (ip poolInstanceProvider) NewInstance(ctx context.Context, pluginCtx backend.PluginContext) (instancemgmt.Instance, error) {
+	instance, err := ip.InstanceProvider.NewInstance(ctx, pluginCtx)
+	if err != nil {
+		return nil, err
+	}
+	if pool, ok := instance.(*Pool); ok {
+		pool.orgID = pluginCtx.OrgID
+	}
+	return instance, nil
+}
+
+// Pool returns the Pool of the data source of pluginCtx.
+func (m *PoolManager) Pool(ctx context.Context, pluginCtx backend.PluginContext) (*Pool, error) {
+	instance, err := m.im.Get(ctx, pluginCtx)
+	if err != nil {
+		return nil, err
+	}
+	pool, ok := instance.(*Pool)
+	if !ok {
+		return nil, fmt.Errorf("unexpected instance type %T", instance)
+	}
+	return pool, nil
+}
+
+// DB returns the connection pool of the data source of pluginCtx, opening it if needed.
+func (m *PoolManager) DB(ctx context.Context, pluginCtx backend.PluginContext) (*sql.DB, error) {
+	pool, err := m.Pool(ctx, pluginCtx)
+	if err != nil {
+		return nil, err
+	}
+	return pool.DB(ctx)
+}
+
+// Pool is the connection pool of a data source instance. It is opened by the first call to DB,
+// and closed by Dispose, which implements instancemgmt.InstanceDisposer.
+type Pool struct {
+	settings backend.DataSourceInstanceSettings
+	orgID    int64
+	opener   PoolOpener
+
+	mu       sync.Mutex
+	db       *sql.DB
+	disposed bool
+}
+
+// DB returns the connection pool, opening it if needed. An error opening the pool is not cached,
+// so the next call tries again.
+//
+// The pool limits are the maxOpenConns, maxIdleConns and connMaxLifetime (in seconds) of the
+// JSONData of the data source when set, and the SQL defaults of the Grafana config of ctx otherwise.
+func (p *Pool) DB(ctx context.Context) (*sql.DB, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.disposed {
+		return nil, errors.New("connection pool is closed")
+	}
+	if p.db != nil {
+		return p.db, nil
+	}
+
+	limits, err := poolLimits(ctx, p.settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer proxy.Dialer
+	proxyClient, err := p.settings.ProxyClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if proxyClient.SecureSocksProxyEnabled() {
+		if dialer, err = proxyClient.NewSecureSocksProxyContextDialer(); err != nil {
+			return nil, err
+		}
+	}
+
+	db, err := p.opener(ctx, p.settings, dialer)
+	if err != nil {
+		return nil, err
+	}
+	limits.apply(db)
+	p.db = db
+	poolStats.add(p.statsKey(), p)
+	return db, nil
+}
+
+// Dispose closes the connection pool. It implements instancemgmt.InstanceDisposer.
+func (p *Pool) Dispose() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.disposed = true
+	if p.db == nil {
+		return
+	}
+	poolStats.remove(p.statsKey(), p)
+	if err := p.db.Close(); err != nil {
+		backend.Logger.Error("Failed to close connection pool", "datasource", p.settings.UID, "error", err)
+	}
+	p.db = nil
+}
+
+// statsKey returns the key of the pool in poolStats.
+func (p *Pool) statsKey() poolStatsKey {
+	return poolStatsKey{uid: p.settings.UID, orgID: p.orgID}
+}
+
+// stats returns the statistics of the pool, and false if it is not open.
+func (p *Pool) stats() (sql.DBStats, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.db == nil {
+		return sql.DBStats{}, false
+	}
+	return p.db.Stats(), true
+}
+
+// poolLimitsConfig are the limits of a pool. Nil limits keep the database/sql defaults.
+type poolLimitsConfig struct {
+	maxOpenConns    *int
+	maxIdleConns    *int
+	connMaxLifetime *int // seconds
+}
+
+func (l poolLimitsConfig) apply(db *sql.DB) {
+	if l.maxOpenConns != nil {
+		db.SetMaxOpenConns(*l.maxOpenConns)
+	}
+	if l.maxIdleConns != nil {
+		db.SetMaxIdleConns(*l.maxIdleConns)
+	}
+	if l.connMaxLifetime != nil {
+		db.SetConnMaxLifetime(time.Duration(*l.connMaxLifetime) * time.Second)
+	}
+}
+
+// poolLimits returns the limits of the pool of the data source.
+func poolLimits(ctx context.Context, settings backend.DataSourceInstanceSettings) (poolLimitsConfig, error) {
+	var limits poolLimitsConfig
+	if sqlCfg, err := config.GrafanaConfigFromContext(ctx).SQL(); err == nil {
+		limits = poolLimitsConfig{
+			maxOpenConns:    &sqlCfg.DefaultMaxOpenConns,
+			maxIdleConns:    &sqlCfg.DefaultMaxIdleConns,
+			connMaxLifetime: &sqlCfg.DefaultMaxConnLifetimeSeconds,
+		}
+	}
+
+	var jsonData struct {
+		MaxOpenConns    *int `json:"maxOpenConns"`
+		MaxIdleConns    *int `json:"maxIdleConns"`
+		ConnMaxLifetime *int `json:"connMaxLifetime"`
+	}
+	if len(settings.JSONData) > 0 {
+		if err := json.Unmarshal(settings.JSONData, &jsonData); err != nil {
+			return limits, backend.DownstreamError(fmt.Errorf("invalid data source settings: %w", err))
+		}
+	}
+	if jsonData.MaxOpenConns != nil {
+		limits.maxOpenConns = jsonData.MaxOpenConns
+	}
+	if jsonData.MaxIdleConns != nil {
+		limits.maxIdleConns = jsonData.MaxIdleConns
+	}
+	if jsonData.ConnMaxLifetime != nil {
+		limits.connMaxLifetime = jsonData.ConnMaxLifetime
+	}
+	return limits, nil
+}
+
+var (
+	poolStats         = &poolStatsCollector{pools: make(map[poolStatsKey]*Pool)}
+	registerPoolStats = sync.OnceFunc(func() { prometheus.MustRegister(poolStats) })
+)
+
+func newPoolStatsDesc(name, help string) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName("plugins", "sql", name), help, []string{"datasource_uid", "org_id"}, nil)
+}
+
+var (
+	poolMaxOpenDesc       = newPoolStatsDesc("max_open_connections", "Maximum number of open connections to the database.")
+	poolOpenDesc          = newPoolStatsDesc("open_connections", "The number of established connections both in use and idle.")
+	poolInUseDesc         = newPoolStatsDesc("in_use_connections", "The number of connections currently in use.")
+	poolIdleDesc          = newPoolStatsDesc("idle_connections", "The number of idle connections.")
+	poolWaitCountDesc     = newPoolStatsDesc("wait_count_total", "The total number of connections waited for.")
+	poolWaitDurationDesc  = newPoolStatsDesc("wait_duration_seconds_total", "The total time blocked waiting for a new connection.")
+	poolMaxIdleClosedDesc = newPoolStatsDesc("max_idle_closed_total", "The total number of connections closed due to SetMaxIdleConns.")
+	poolMaxIdleTimeDesc   = newPoolStatsDesc("max_idle_time_closed_total", "The total number of connections closed due to SetConnMaxIdleTime.")
+	poolMaxLifetimeDesc   = newPoolStatsDesc("max_lifetime_closed_total", "The total number of connections closed due to SetConnMaxLifetime.")
+	poolStatsDescs        = []*prometheus.Desc{poolMaxOpenDesc, poolOpenDesc, poolInUseDesc, poolIdleDesc, poolWaitCountDesc, poolWaitDurationDesc, poolMaxIdleClosedDesc, poolMaxIdleTimeDesc, poolMaxLifetimeDesc}
+)
+
+var _ prometheus.Collector = (*poolStatsCollector)(nil)
+
+// poolStatsKey identifies a data source: data source UIDs are only unique within an org.
+type poolStatsKey struct {
+	uid   string
+	orgID int64
+}
+
+// poolStatsCollector collects the sql.DBStats of the open pools, by data source UID and org.
+// When a pool is replaced, the new pool is collected while the old one waits to be disposed.
+type poolStatsCollector struct {
+	mu    sync.Mutex
+	pools map[poolStatsKey]*Pool
+}
+
+func (c *poolStatsCollector) add(key poolStatsKey, pool *Pool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pools[key] = pool
+}
+
+func (c *poolStatsCollector) remove(key poolStatsKey, pool *Pool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pools[key] == pool {
+		delete(c.pools, key)
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *poolStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range poolStatsDescs {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *poolStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	pools := make(map[poolStatsKey]*Pool, len(c.pools))
+	for key, pool := range c.pools {
+		pools[key] = pool
+	}
+	c.mu.Unlock()
+
+	for key, pool := range pools {
+		stats, ok := pool.stats()
+		if !ok {
+			continue
+		}
+		uid, org := key.uid, strconv.FormatInt(key.orgID, 10)
+		ch <- prometheus.MustNewConstMetric(poolMaxOpenDesc, prometheus.GaugeValue, float64(stats.MaxOpenConnections), uid, org)
+		ch <- prometheus.MustNewConstMetric(poolOpenDesc, prometheus.GaugeValue, float64(stats.OpenConnections), uid, org)
+		ch <- prometheus.MustNewConstMetric(poolInUseDesc, prometheus.GaugeValue, float64(stats.InUse), uid, org)
+		ch <- prometheus.MustNewConstMetric(poolIdleDesc, prometheus.GaugeValue, float64(stats.Idle), uid, org)
+		ch <- prometheus.MustNewConstMetric(poolWaitCountDesc, prometheus.CounterValue, float64(stats.WaitCount), uid, org)
+		ch <- prometheus.MustNewConstMetric(poolWaitDurationDesc, prometheus.CounterValue, stats.WaitDuration.Seconds(), uid, org)
+		ch <- prometheus.MustNewConstMetric(poolMaxIdleClosedDesc, prometheus.CounterValue, float64(stats.MaxIdleClosed), uid, org)
+		ch <- prometheus.MustNewConstMetric(poolMaxIdleTimeDesc, prometheus.CounterValue, float64(stats.MaxIdleTimeClosed), uid, org)
+		ch <- prometheus.MustNewConstMetric(poolMaxLifetimeDesc, prometheus.CounterValue, float64(stats.MaxLifetimeClosed), uid, org)
+	}
+}
@@ -0,0 +1,108 @@
+package sqlutil_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/proxy"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/config"
+	"github.com/grafana/grafana-plugin-sdk-go/data/sqlutil"
+)
+
+func TestPoolManager(t *testing.T) {
+	grafanaCfg := config.NewGrafanaCfg(map[string]string{
+		config.SQLMaxOpenConnsDefault:           "10",
+		config.SQLMaxIdleConnsDefault:           "5",
+		config.SQLMaxConnLifetimeSecondsDefault: "60",
+		config.SQLRowLimit:                      "1000",
+	})
+	ctx := config.WithGrafanaConfig(context.Background(), grafanaCfg)
+
+	opened := 0
+	manager := sqlutil.NewPoolManager(func(_ context.Context, _ backend.DataSourceInstanceSettings, dialer proxy.Dialer) (*sql.DB, error) {
+		require.Nil(t, dialer)
+		opened++
+		return sql.OpenDB(&fakeDB{}), nil
+	})
+
+	updated := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pluginCtx := backend.PluginContext{
+		OrgID:         1,
+		GrafanaConfig: grafanaCfg,
+		DataSourceInstanceSettings: &backend.DataSourceInstanceSettings{
+			ID:       1,
+			UID:      "pool-test",
+			Updated:  updated,
+			JSONData: []byte(`{"maxOpenConns": 3}`),
+		},
+	}
+
+	pool, err := manager.Pool(ctx, pluginCtx)
+	require.NoError(t, err)
+	require.Equal(t, 0, opened, "pools are opened lazily")
+
+	db, err := manager.DB(ctx, pluginCtx)
+	require.NoError(t, err)
+	again, err := manager.DB(ctx, pluginCtx)
+	require.NoError(t, err)
+	require.Same(t, db, again)
+	require.Equal(t, 1, opened)
+	require.Equal(t, 3, db.Stats().MaxOpenConnections)
+
+	t.Run("exports pool stats by org", func(t *testing.T) {
+		// the same data source UID in another org
+		otherOrgCtx := pluginCtx
+		otherOrgCtx.OrgID = 2
+		settings := *pluginCtx.DataSourceInstanceSettings
+		settings.ID = 2
+		settings.JSONData = []byte(`{"maxOpenConns": 4}`)
+		otherOrgCtx.DataSourceInstanceSettings = &settings
+		_, err := manager.DB(ctx, otherOrgCtx)
+		require.NoError(t, err)
+
+		families, err := prometheus.DefaultGatherer.Gather()
+		require.NoError(t, err)
+		maxOpen := map[string]float64{}
+		for _, family := range families {
+			if family.GetName() != "plugins_sql_max_open_connections" {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				labels := map[string]string{}
+				for _, label := range metric.GetLabel() {
+					labels[label.GetName()] = label.GetValue()
+				}
+				if labels["datasource_uid"] == "pool-test" {
+					maxOpen[labels["org_id"]] = metric.GetGauge().GetValue()
+				}
+			}
+		}
+		require.Equal(t, map[string]float64{"1": 3, "2": 4}, maxOpen)
+	})
+
+	t.Run("updated settings open a new pool", func(t *testing.T) {
+		updatedCtx := pluginCtx
+		settings := *pluginCtx.DataSourceInstanceSettings
+		settings.Updated = updated.Add(time.Minute)
+		settings.JSONData = nil
+		updatedCtx.DataSourceInstanceSettings = &settings
+
+		newDB, err := manager.DB(ctx, updatedCtx)
+		require.NoError(t, err)
+		require.NotSame(t, db, newDB)
+		require.Equal(t, 10, newDB.Stats().MaxOpenConnections)
+	})
+
+	t.Run("dispose closes the pool", func(t *testing.T) {
+		pool.Dispose()
+		require.Error(t, db.Ping())
+		_, err := pool.DB(ctx)
+		require.Error(t, err)
+	})
+}
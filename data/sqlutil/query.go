@@ -80,6 +80,9 @@ type Query struct {
 	MaxDataPoints int64             `json:"-"`
 	FillMissing   *data.FillMissing `json:"fillMode,omitempty"`
 
+	// Dialect is the SQL flavour the default macros generate. When nil, they generate standard SQL.
+	Dialect Dialect `json:"-"`
+
 	// Macros
 	Schema string `json:"schema,omitempty"`
 	Table  string `json:"table,omitempty"`
@@ -98,12 +101,20 @@ func (q *Query) WithSQL(query string) *Query {
 		TimeRange:      q.TimeRange,
 		MaxDataPoints:  q.MaxDataPoints,
 		FillMissing:    q.FillMissing,
+		Dialect:        q.Dialect,
 		Schema:         q.Schema,
 		Table:          q.Table,
 		Column:         q.Column,
 	}
 }
 
+func (q *Query) dialect() Dialect {
+	if q.Dialect == nil {
+		return genericDialect
+	}
+	return q.Dialect
+}
+
 // GetQuery returns a Query object given a backend.DataQuery using json.Unmarshal
 func GetQuery(query backend.DataQuery) (*Query, error) {
 	model := &Query{}
@@ -0,0 +1,453 @@
+package sqlutil
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+)
+
+// ErrorNotReadOnly is returned by CheckReadOnly when a query is not a single read-only statement.
+var ErrorNotReadOnly = errors.New("only read-only queries are allowed")
+
+// StatementKind is the kind of an SQL statement, as classified by AnalyzeSQL.
+type StatementKind int
+
+const (
+	// StatementSelect is a statement that only reads, such as SELECT, WITH, SHOW or EXPLAIN.
+	StatementSelect StatementKind = iota
+	// StatementDML is a statement that modifies data, such as INSERT, UPDATE, DELETE or SELECT INTO.
+	StatementDML
+	// StatementDDL is a statement that modifies the schema or permissions, such as CREATE, DROP or GRANT.
+	StatementDDL
+	// StatementOther is any other statement, such as SET, USE, BEGIN or a procedure call.
+	StatementOther
+)
+
+func (k StatementKind) String() string {
+	switch k {
+	case StatementSelect:
+		return "select"
+	case StatementDML:
+		return "dml"
+	case StatementDDL:
+		return "ddl"
+	}
+	return "other"
+}
+
+// Statement is a statement of an SQL query.
+type Statement struct {
+	Kind StatementKind
+	// Keyword is the upper case keyword the Kind is based on: the first keyword of the statement,
+	// or the keyword that makes a reading statement write, such as INTO or a data-modifying CTE's DELETE.
+	Keyword string
+}
+
+var (
+	readKeywords = map[string]bool{
+		"SELECT": true, "WITH": true, "VALUES": true, "TABLE": true, "SHOW": true, "DESCRIBE": true, "DESC": true, "EXPLAIN": true,
+	}
+	dmlKeywords = map[string]bool{
+		"INSERT": true, "UPDATE": true, "DELETE": true, "MERGE": true, "UPSERT": true, "REPLACE": true, "COPY": true, "LOAD": true, "INTO": true,
+	}
+	ddlKeywords = map[string]bool{
+		"CREATE": true, "ALTER": true, "DROP": true, "TRUNCATE": true, "RENAME": true, "COMMENT": true,
+		"GRANT": true, "REVOKE": true, "DENY": true, "ATTACH": true, "DETACH": true,
+	}
+	// writeKeywords are the keywords that make a statement that starts like a read write,
+	// wherever they are, as in SELECT INTO, data-modifying CTEs or T-SQL batches without semicolons.
+	// REPLACE, COPY and LOAD are left out as they are also common function or column names.
+	writeKeywords = map[string]StatementKind{
+		"INSERT": StatementDML, "UPDATE": StatementDML, "DELETE": StatementDML, "MERGE": StatementDML, "UPSERT": StatementDML, "INTO": StatementDML,
+		"CREATE": StatementDDL, "ALTER": StatementDDL, "DROP": StatementDDL, "TRUNCATE": StatementDDL, "RENAME": StatementDDL,
+		"GRANT": StatementDDL, "REVOKE": StatementDDL, "DENY": StatementDDL,
+		"EXEC": StatementOther, "EXECUTE": StatementOther, "CALL": StatementOther, "SHUTDOWN": StatementOther, "KILL": StatementOther,
+	}
+)
+
+// CheckReadOnly returns an error if query is not a single statement that only reads data, as
+// classified by AnalyzeSQL for dialect. The error is a downstream backend.ErrorWithSource wrapping
+// ErrorNotReadOnly, or the syntax error of AnalyzeSQL.
+//
+// It must be called on the query after macro interpolation, as macros could generate writes.
+// InterpolateReadOnly does both. The check is conservative, and rejects a few reading queries
+// such as SELECT ... FOR UPDATE, but it can not detect functions with side effects: it is not a
+// replacement for a read-only database user.
+func CheckReadOnly(query string, dialect Dialect) error {
+	statements, err := AnalyzeSQL(query, dialect)
+	if err != nil {
+		return backend.DownstreamError(fmt.Errorf("%w: %w", ErrorNotReadOnly, err))
+	}
+	return checkReadOnly(statements)
+}
+
+// InterpolateReadOnly interpolates the macros of query like Interpolate, and checks that the
+// interpolated query is read-only with CheckReadOnly and the Dialect of query.
+func InterpolateReadOnly(query *Query, macros Macros) (string, error) {
+	rawSQL, err := Interpolate(query, macros)
+	if err != nil {
+		return rawSQL, err
+	}
+	if err := CheckReadOnly(rawSQL, query.dialect()); err != nil {
+		return rawSQL, err
+	}
+	return rawSQL, nil
+}
+
+func checkReadOnly(statements []Statement) error {
+	switch {
+	case len(statements) == 0:
+		return backend.DownstreamError(fmt.Errorf("%w: the query has no statement", ErrorNotReadOnly))
+	case len(statements) > 1:
+		return backend.DownstreamError(fmt.Errorf("%w: the query has %d statements", ErrorNotReadOnly, len(statements)))
+	case statements[0].Kind != StatementSelect:
+		return backend.DownstreamError(fmt.Errorf("%w: %s is a %s statement", ErrorNotReadOnly, statements[0].Keyword, statements[0].Kind))
+	}
+	return nil
+}
+
+// AnalyzeSQL splits query into its statements and classifies them, with the comment, string and
+// quoting rules of dialect. Comments are skipped, except the MySQL executable comments (/*! ... */)
+// that MySQL runs. Empty statements are ignored.
+//
+// When the rules depend on database settings, such as backslash escapes in MySQL strings or its
+// ANSI_QUOTES mode, the query is analyzed with every combination of them, and the statements of an
+// interpretation that is not read-only are returned if there is one. When dialect is nil or is not
+// one of the dialects of this package, the rules of the database are not known, so the query is
+// analyzed with the rules of standard SQL and of every dialect of this package in the same way.
+func AnalyzeSQL(query string, dialect Dialect) ([]Statement, error) {
+	var result []Statement
+	var firstErr error
+	for _, rules := range lexerRulesOf(dialect) {
+		for _, settings := range rules.settings {
+			r := rules
+			r.lexerSettings = settings
+			tokens, err := r.tokenize(query)
+			if err != nil {
+				// the database fails on the query when it has this interpretation
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			statements := classifyStatements(tokens)
+			if checkReadOnly(statements) != nil {
+				return statements, nil
+			}
+			if result == nil {
+				result = statements
+			}
+		}
+	}
+	if result == nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
+
+// classifyStatements splits the tokens on semicolons and classifies each statement.
+func classifyStatements(tokens []sqlToken) []Statement {
+	var statements []Statement
+	start := 0
+	for i := 0; i <= len(tokens); i++ {
+		if i < len(tokens) && !(tokens[i].kind == tokenPunct && tokens[i].text == ";") {
+			continue
+		}
+		if i > start {
+			statements = append(statements, classifyStatement(tokens[start:i]))
+		}
+		start = i + 1
+	}
+	return statements
+}
+
+func classifyStatement(tokens []sqlToken) Statement {
+	first := 0
+	for first < len(tokens) && tokens[first].kind == tokenPunct && tokens[first].text == "(" {
+		first++
+	}
+	if first == len(tokens) || tokens[first].kind != tokenWord {
+		return Statement{Kind: StatementOther, Keyword: strings.ToUpper(tokens[0].text)}
+	}
+
+	keyword := strings.ToUpper(tokens[first].text)
+	switch {
+	case dmlKeywords[keyword]:
+		return Statement{Kind: StatementDML, Keyword: keyword}
+	case ddlKeywords[keyword]:
+		return Statement{Kind: StatementDDL, Keyword: keyword}
+	case !readKeywords[keyword]:
+		return Statement{Kind: StatementOther, Keyword: keyword}
+	}
+
+	for i := first + 1; i < len(tokens); i++ {
+		token := tokens[i]
+		// words after a dot are qualified names, not keywords, and SHOW CREATE TABLE only reads
+		if token.kind != tokenWord || (tokens[i-1].kind == tokenPunct && tokens[i-1].text == ".") ||
+			(keyword == "SHOW" && i == first+1) {
+			continue
+		}
+		word := strings.ToUpper(token.text)
+		if kind, ok := writeKeywords[word]; ok {
+			return Statement{Kind: kind, Keyword: word}
+		}
+	}
+	return Statement{Kind: StatementSelect, Keyword: keyword}
+}
+
+type tokenKind int
+
+const (
+	tokenWord   tokenKind = iota // keyword, unquoted identifier or number
+	tokenQuoted                  // string literal or quoted identifier
+	tokenPunct                   // any other character
+)
+
+type sqlToken struct {
+	kind tokenKind
+	text string
+}
+
+// lexerRules are the lexical rules of a dialect.
+type lexerRules struct {
+	hashComments        bool // # starts a line comment
+	nestedComments      bool // block comments nest
+	executableComments  bool // /*! ... */ and /*M! ... */ are code
+	dollarQuotes        bool // $tag$ ... $tag$ strings
+	escapeStrings       bool // E'...' strings have backslash escapes
+	bracketIdentifiers  bool // [...] identifiers
+	backtickIdentifiers bool // `...` identifiers
+
+	// settings are the interpretations of the rules that depend on database settings to analyze.
+	settings []lexerSettings
+	lexerSettings
+}
+
+// lexerSettings are the lexical rules of a dialect that depend on database settings.
+type lexerSettings struct {
+	backslashEscape      bool // backslash escapes apply to '...' strings
+	doubleQuoteEscapes   bool // backslash escapes apply to "..." too
+	dashCommentNeedSpace bool // -- starts a line comment only when followed by whitespace
+}
+
+// settingsCombinations returns every combination of the given values of the lexer settings.
+func settingsCombinations(backslashEscape, doubleQuoteEscapes, dashCommentNeedSpace []bool) []lexerSettings {
+	var combinations []lexerSettings
+	for _, b := range backslashEscape {
+		for _, d := range doubleQuoteEscapes {
+			for _, s := range dashCommentNeedSpace {
+				combinations = append(combinations, lexerSettings{backslashEscape: b, doubleQuoteEscapes: d, dashCommentNeedSpace: s})
+			}
+		}
+	}
+	return combinations
+}
+
+var dialectLexerRules = map[string]lexerRules{
+	// backslashes are escapes in strings when standard_conforming_strings is off
+	"postgres": {nestedComments: true, dollarQuotes: true, escapeStrings: true,
+		settings: settingsCombinations([]bool{false, true}, []bool{false}, []bool{false})},
+	// backslashes are escapes in strings unless the NO_BACKSLASH_ESCAPES mode is set,
+	// and "..." are identifiers without escapes instead of strings in the ANSI_QUOTES mode
+	"mysql": {hashComments: true, executableComments: true, backtickIdentifiers: true,
+		settings: settingsCombinations([]bool{false, true}, []bool{false, true}, []bool{true})},
+	"mssql":      {nestedComments: true, bracketIdentifiers: true, settings: []lexerSettings{{}}},
+	"sqlite":     {bracketIdentifiers: true, backtickIdentifiers: true, settings: []lexerSettings{{}}},
+	"clickhouse": {hashComments: true, backtickIdentifiers: true, settings: []lexerSettings{{backslashEscape: true, doubleQuoteEscapes: true}}},
+}
+
+// lexerRulesOf returns the lexer rules to analyze queries of dialect with: the rules of a dialect
+// of this package, or else standard SQL and the rules of all the dialects of this package.
+func lexerRulesOf(dialect Dialect) []lexerRules {
+	if dialect != nil {
+		if rules, ok := dialectLexerRules[dialect.Name()]; ok {
+			return []lexerRules{rules}
+		}
+	}
+	all := []lexerRules{{settings: settingsCombinations([]bool{false, true}, []bool{false, true}, []bool{false, true})}}
+	for _, name := range []string{"postgres", "mysql", "mssql", "sqlite", "clickhouse"} {
+		all = append(all, dialectLexerRules[name])
+	}
+	return all
+}
+
+// tokenize returns the tokens of query without whitespace and comments.
+func (r lexerRules) tokenize(query string) ([]sqlToken, error) {
+	var tokens []sqlToken
+	src := []rune(query)
+	inExecutableComment := false
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		next := rune(0)
+		if i+1 < len(src) {
+			next = src[i+1]
+		}
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '-' && next == '-' && (!r.dashCommentNeedSpace || i+2 == len(src) || unicode.IsSpace(src[i+2]) || unicode.IsControl(src[i+2])),
+			c == '#' && r.hashComments:
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '*' && next == '/' && inExecutableComment:
+			inExecutableComment = false
+			i += 2
+		case c == '/' && next == '*':
+			if r.executableComments && !inExecutableComment {
+				if rest := string(src[i+2 : min(i+4, len(src))]); strings.HasPrefix(rest, "!") || strings.HasPrefix(rest, "M!") {
+					// MySQL runs the code of the comment, after an optional version number
+					i += 2 + strings.Index(rest, "!") + 1
+					for i < len(src) && unicode.IsDigit(src[i]) {
+						i++
+					}
+					inExecutableComment = true
+					continue
+				}
+			}
+			end, err := r.skipBlockComment(src, i)
+			if err != nil {
+				return nil, err
+			}
+			i = end
+		case c == '\'':
+			end, err := r.skipQuoted(src, i, '\'', r.backslashEscape)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, sqlToken{kind: tokenQuoted, text: string(src[i:end])})
+			i = end
+		case c == '"':
+			end, err := r.skipQuoted(src, i, '"', r.backslashEscape && r.doubleQuoteEscapes)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, sqlToken{kind: tokenQuoted, text: string(src[i:end])})
+			i = end
+		case c == '`' && r.backtickIdentifiers:
+			end, err := r.skipQuoted(src, i, '`', false)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, sqlToken{kind: tokenQuoted, text: string(src[i:end])})
+			i = end
+		case c == '[' && r.bracketIdentifiers:
+			end, err := r.skipQuoted(src, i, ']', false)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, sqlToken{kind: tokenQuoted, text: string(src[i:end])})
+			i = end
+		case c == '$' && r.dollarQuotes && !unicode.IsDigit(next):
+			end, ok, err := skipDollarQuoted(src, i)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				tokens = append(tokens, sqlToken{kind: tokenPunct, text: string(c)})
+				i++
+				continue
+			}
+			tokens = append(tokens, sqlToken{kind: tokenQuoted, text: string(src[i:end])})
+			i = end
+		case isWordStart(c):
+			start := i
+			for i < len(src) && isWordPart(src[i]) {
+				i++
+			}
+			word := string(src[start:i])
+			if r.escapeStrings && i < len(src) && src[i] == '\'' && strings.EqualFold(word, "E") {
+				end, err := r.skipQuoted(src, i, '\'', true)
+				if err != nil {
+					return nil, err
+				}
+				tokens = append(tokens, sqlToken{kind: tokenQuoted, text: string(src[start:end])})
+				i = end
+				continue
+			}
+			tokens = append(tokens, sqlToken{kind: tokenWord, text: word})
+		default:
+			tokens = append(tokens, sqlToken{kind: tokenPunct, text: string(c)})
+			i++
+		}
+	}
+
+	if inExecutableComment {
+		return nil, errors.New("unterminated comment")
+	}
+	return tokens, nil
+}
+
+// skipBlockComment returns the index after the block comment starting at start.
+func (r lexerRules) skipBlockComment(src []rune, start int) (int, error) {
+	depth := 0
+	for i := start; i+1 < len(src); {
+		switch {
+		case src[i] == '/' && src[i+1] == '*':
+			if depth == 0 || r.nestedComments {
+				depth++
+			}
+			i += 2
+		case src[i] == '*' && src[i+1] == '/':
+			depth--
+			i += 2
+			if depth == 0 {
+				return i, nil
+			}
+		default:
+			i++
+		}
+	}
+	return 0, errors.New("unterminated comment")
+}
+
+// skipQuoted returns the index after the string or identifier starting at start and ending with
+// closing, which is escaped by doubling it, or by a backslash if backslashEscape.
+func (r lexerRules) skipQuoted(src []rune, start int, closing rune, backslashEscape bool) (int, error) {
+	for i := start + 1; i < len(src); i++ {
+		switch {
+		case backslashEscape && src[i] == '\\':
+			i++
+		case src[i] == closing:
+			if i+1 < len(src) && src[i+1] == closing {
+				i++
+				continue
+			}
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated quoted string or identifier starting with %c", src[start])
+}
+
+// skipDollarQuoted returns the index after the dollar-quoted string starting at start,
+// and false if there is no dollar quote at start.
+func skipDollarQuoted(src []rune, start int) (int, bool, error) {
+	i := start + 1
+	for i < len(src) && (unicode.IsLetter(src[i]) || unicode.IsDigit(src[i]) || src[i] == '_') {
+		i++
+	}
+	if i == len(src) || src[i] != '$' {
+		return 0, false, nil
+	}
+	tag := string(src[start : i+1])
+	tagLen := i + 1 - start
+	for j := i + 1; j+tagLen <= len(src); j++ {
+		if string(src[j:j+tagLen]) == tag {
+			return j + tagLen, true, nil
+		}
+	}
+	return 0, false, errors.New("unterminated dollar-quoted string")
+}
+
+func isWordStart(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '@' || c == '#'
+}
+
+func isWordPart(c rune) bool {
+	return isWordStart(c) || c == '$'
+}
@@ -0,0 +1,171 @@
+package sqlutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/data/sqlutil"
+)
+
+func TestAnalyzeSQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  sqlutil.Dialect
+		query    string
+		expected []sqlutil.Statement
+	}{
+		{
+			name:     "select",
+			query:    "SELECT * FROM t WHERE a = 'DROP TABLE t; --'",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}},
+		},
+		{
+			name:     "trailing semicolon and comments",
+			query:    "-- DELETE FROM t;\n/* INSERT INTO t VALUES (1); */ (select 1);;",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}},
+		},
+		{
+			name:  "multiple statements",
+			query: "select 1; update t set a = 1; drop table t",
+			expected: []sqlutil.Statement{
+				{Kind: sqlutil.StatementSelect, Keyword: "SELECT"},
+				{Kind: sqlutil.StatementDML, Keyword: "UPDATE"},
+				{Kind: sqlutil.StatementDDL, Keyword: "DROP"},
+			},
+		},
+		{
+			name:     "data-modifying CTE",
+			dialect:  sqlutil.PostgresDialect,
+			query:    "WITH d AS (DELETE FROM t RETURNING *) SELECT * FROM d",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementDML, Keyword: "DELETE"}},
+		},
+		{
+			name:     "select into",
+			dialect:  sqlutil.MSSQLDialect,
+			query:    "SELECT * INTO [backup] FROM t",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementDML, Keyword: "INTO"}},
+		},
+		{
+			name:     "T-SQL batch without semicolons",
+			dialect:  sqlutil.MSSQLDialect,
+			query:    "SELECT 1 DROP TABLE t",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementDDL, Keyword: "DROP"}},
+		},
+		{
+			name:     "qualified names are not keywords",
+			dialect:  sqlutil.PostgresDialect,
+			query:    `SELECT t.update, "delete" FROM t`,
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}},
+		},
+		{
+			name:     "postgres nested comment and dollar quotes",
+			dialect:  sqlutil.PostgresDialect,
+			query:    "SELECT $body$ ; DROP TABLE t $body$ /* outer /* inner */ ; DELETE FROM t */",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}},
+		},
+		{
+			name:     "mysql executable comment",
+			dialect:  sqlutil.MySQLDialect,
+			query:    "SELECT 1 /*!50000 ; DROP TABLE t */",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}, {Kind: sqlutil.StatementDDL, Keyword: "DROP"}},
+		},
+		{
+			name:     "mysql dash comment needs a space",
+			dialect:  sqlutil.MySQLDialect,
+			query:    "SELECT 1 --1; DROP TABLE t",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}, {Kind: sqlutil.StatementDDL, Keyword: "DROP"}},
+		},
+		{
+			name:     "mysql hash comment",
+			dialect:  sqlutil.MySQLDialect,
+			query:    "SELECT 1 # ; DROP TABLE t",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}},
+		},
+		{
+			name:     "mysql string with backslash escapes or not",
+			dialect:  sqlutil.MySQLDialect,
+			query:    `SELECT 'a\'; DROP TABLE t; -- '`,
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}, {Kind: sqlutil.StatementDDL, Keyword: "DROP"}},
+		},
+		{
+			name:     "mysql ANSI_QUOTES mode",
+			dialect:  sqlutil.MySQLDialect,
+			query:    `SELECT "\" , '\' , ' ; DROP TABLE t ; -- '`,
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}, {Kind: sqlutil.StatementDDL, Keyword: "DROP"}},
+		},
+		{
+			name:     "dash comment without a space and without dialect",
+			query:    "SELECT 1 --x; DROP TABLE t",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}, {Kind: sqlutil.StatementDDL, Keyword: "DROP"}},
+		},
+		{
+			name:     "bracket identifier without dialect",
+			query:    "SELECT [a'] FROM t; DROP TABLE x --']",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}, {Kind: sqlutil.StatementDDL, Keyword: "DROP"}},
+		},
+		{
+			name:     "hash comment without dialect",
+			query:    "SELECT 1 # '\n; DROP TABLE t; -- '",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SELECT"}, {Kind: sqlutil.StatementDDL, Keyword: "DROP"}},
+		},
+		{
+			name:     "show create table",
+			dialect:  sqlutil.ClickHouseDialect,
+			query:    "SHOW CREATE TABLE `t`",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementSelect, Keyword: "SHOW"}},
+		},
+		{
+			name:     "other statement",
+			dialect:  sqlutil.SQLiteDialect,
+			query:    "PRAGMA journal_mode = DELETE",
+			expected: []sqlutil.Statement{{Kind: sqlutil.StatementOther, Keyword: "PRAGMA"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements, err := sqlutil.AnalyzeSQL(tt.query, tt.dialect)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, statements)
+		})
+	}
+
+	t.Run("unterminated string", func(t *testing.T) {
+		_, err := sqlutil.AnalyzeSQL("SELECT 'a", sqlutil.PostgresDialect)
+		require.Error(t, err)
+	})
+}
+
+func TestCheckReadOnly(t *testing.T) {
+	require.NoError(t, sqlutil.CheckReadOnly("SELECT * FROM t;", sqlutil.PostgresDialect))
+
+	for _, query := range []string{"", "SELECT 1; SELECT 2", "DELETE FROM t", "SELECT 'a"} {
+		err := sqlutil.CheckReadOnly(query, sqlutil.PostgresDialect)
+		require.Error(t, err, query)
+		require.True(t, errors.Is(err, sqlutil.ErrorNotReadOnly), query)
+		require.True(t, backend.IsDownstreamError(err), query)
+	}
+
+	require.ErrorIs(t, sqlutil.CheckReadOnly(`SELECT "\" , '\' , ' ; DROP TABLE t ; -- '`, sqlutil.MySQLDialect), sqlutil.ErrorNotReadOnly)
+	require.ErrorIs(t, sqlutil.CheckReadOnly("SELECT 1 --x; DROP TABLE t", nil), sqlutil.ErrorNotReadOnly)
+	require.ErrorIs(t, sqlutil.CheckReadOnly("SELECT [a'] FROM t; DROP TABLE x --']", nil), sqlutil.ErrorNotReadOnly)
+	require.ErrorIs(t, sqlutil.CheckReadOnly("SELECT 1 # '\n; DROP TABLE t; -- '", nil), sqlutil.ErrorNotReadOnly)
+	require.NoError(t, sqlutil.CheckReadOnly("SELECT a, 'b' FROM t -- comment", nil))
+}
+
+func TestInterpolateReadOnly(t *testing.T) {
+	macros := sqlutil.Macros{
+		"smuggle": func(*sqlutil.Query, []string) (string, error) { return "1; DROP TABLE t", nil },
+	}
+
+	query := &sqlutil.Query{RawSQL: "SELECT $__smuggle", Dialect: sqlutil.MySQLDialect}
+	_, err := sqlutil.InterpolateReadOnly(query, macros)
+	require.ErrorIs(t, err, sqlutil.ErrorNotReadOnly)
+
+	query = &sqlutil.Query{RawSQL: "SELECT * FROM t WHERE $__unixEpochFilter(ts)", Dialect: sqlutil.MySQLDialect}
+	rawSQL, err := sqlutil.InterpolateReadOnly(query, macros)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM t WHERE ts >= -62135596800 AND ts <= -62135596800", rawSQL)
+}
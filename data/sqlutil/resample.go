@@ -69,7 +69,7 @@ func getRowFillValues(f *data.Frame, tsSchema data.TimeSeriesSchema, currentTime
 // therefore needs to be resampled.
 //
 // Deprecated: only used in legacy situations, for new projects
-// use dataplane-based solutions.
+// use dataplane-based solutions, or data.Resample to resample time series.
 func ResampleWideFrame(f *data.Frame, fillMissing *data.FillMissing, timeRange backend.TimeRange, interval time.Duration) (*data.Frame, error) {
 	tsSchema := f.TimeSeriesSchema()
 	if tsSchema.Type == data.TimeSeriesTypeNot {
@@ -68,6 +68,9 @@ func (s *ScanRow) NewScannableRow() []interface{} {
 // Applicable converters will substitute the SQL scan type with the one provided by the converter.
 // The list of returned converters is the same length as the SQL rows and corresponds with the rows at the same index. (e.g. value at slice element 3 corresponds with the converter at slice element 3)
 // If no converter is provided for a row that has a type that does not fit into a dataframe, it is skipped.
+//
+// To scan columns of exact decimal database types into data.Decimal Fields, pass NullExactDecimalConverter
+// with IsDecimalDatabaseType as its InputTypeMatcher.
 func MakeScanRow(colTypes []*sql.ColumnType, colNames []string, converters ...Converter) (*RowConverter, error) {
 	// In the future we can probably remove this restriction. But right now we map names to Arrow Field Names.
 	// Arrow Field names must be unique: https://github.com/grafana/grafana-plugin-sdk-go/issues/59
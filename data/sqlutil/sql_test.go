@@ -6,11 +6,14 @@ import (
 	"database/sql/driver"
 	"errors"
 	"io"
+	"math/big"
 	"reflect"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 
 	"github.com/grafana/grafana-plugin-sdk-go/data"
@@ -686,6 +689,66 @@ func TestFrameFromRowsConvertWithColumnReceivesColumnType(t *testing.T) {
 	require.Equal(t, []string{"TEST_TYPE", "TEST_TYPE"}, seenTypeNames)
 }
 
+func TestFrameFromRowsDecimalAndDuration(t *testing.T) {
+	decimal := func(s string) *data.Decimal {
+		d, err := data.ParseDecimal(s)
+		require.NoError(t, err)
+		return &d
+	}
+
+	rows := makeSingleResultSetWithTypeNames( //nolint:rowserrcheck
+		[]string{"price", "ratio"},
+		[]string{"DECIMAL(38, 10)", "numeric"},
+		[]interface{}{"12345678901234567890.0123456789", []byte("0.1")},
+		[]interface{}{nil, 1.5},
+	)
+	exact := sqlutil.NullExactDecimalConverter
+	exact.InputTypeMatcher = sqlutil.IsDecimalDatabaseType
+	frame, err := sqlutil.FrameFromRows(rows, 100, exact)
+	require.NoError(t, err)
+	expected := data.NewFrame("",
+		data.NewField("price", nil, []*data.Decimal{decimal("12345678901234567890.0123456789"), nil}),
+		data.NewField("ratio", nil, []*data.Decimal{decimal("0.1"), decimal("1.5")}),
+	)
+	if diff := cmp.Diff(expected, frame, data.FrameTestCompareOptions()...); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	// without the converter, decimal columns keep the type of the driver's scan type
+	rows = makeSingleResultSetWithTypeNames( //nolint:rowserrcheck
+		[]string{"price"},
+		[]string{"DECIMAL(38, 10)"},
+		[]interface{}{"1.5"},
+	)
+	frame, err = sqlutil.FrameFromRows(rows, 100)
+	require.NoError(t, err)
+	require.NotEqual(t, data.FieldTypeNullableDecimal, frame.Fields[0].Type())
+
+	// exact decimal scan types are decimal Fields
+	rows = makeSingleResultSetWithScanTypes( //nolint:rowserrcheck
+		[]string{"price"},
+		[]reflect.Type{reflect.TypeOf(big.Int{})},
+		[]interface{}{"12345678901234567890123"},
+		[]interface{}{nil},
+	)
+	frame, err = sqlutil.FrameFromRows(rows, 100)
+	require.NoError(t, err)
+	require.Equal(t, data.FieldTypeNullableDecimal, frame.Fields[0].Type())
+	require.Equal(t, "12345678901234567890123", frame.Fields[0].At(0).(*data.Decimal).String())
+	require.True(t, frame.Fields[0].NilAt(1))
+
+	rows = makeSingleResultSetWithScanTypes( //nolint:rowserrcheck
+		[]string{"elapsed"},
+		[]reflect.Type{reflect.TypeOf(time.Duration(0))},
+		[]interface{}{int64(time.Second)},
+		[]interface{}{nil},
+	)
+	frame, err = sqlutil.FrameFromRows(rows, 100)
+	require.NoError(t, err)
+	second := time.Second
+	require.Equal(t, data.NewField("elapsed", nil, []*time.Duration{&second, nil}), frame.Fields[0])
+}
+
 func TestFrameFromRows_MultipleTimes(t *testing.T) {
 	ptr := func(s string) *string {
 		return &s
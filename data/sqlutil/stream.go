@@ -0,0 +1,129 @@
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// DefaultStreamBatchSize is the number of rows of each frame written by StreamFrameFromRows
+// when StreamOptions.BatchSize is not set.
+const DefaultStreamBatchSize = 1000
+
+// StreamOptions configure StreamFrameFromRows.
+type StreamOptions struct {
+	// BatchSize is the maximum number of rows of each frame written. Defaults to DefaultStreamBatchSize.
+	BatchSize int
+
+	// RowLimit is the maximum number of rows written. If it is 0 or less, there is no limit, so that
+	// the zero StreamOptions are unlimited. Note that a rowLimit of 0 makes FrameFromRows return no rows.
+	RowLimit int64
+}
+
+// StreamFrameFromRows scans rows in batches and writes each batch as a frame with the given refID
+// and frameID to w, so the writer appends them to a single frame and the first rows are sent before
+// the whole result set is scanned. Only one batch is held in memory at a time.
+//
+// The frames have the same fields as the frame returned by FrameFromRows for the same rows and
+// converters. A frame without rows is written if rows is empty, so the schema is always sent.
+// If the RowLimit is reached, a data.Notice with a warning severity is written in a frame without
+// fields with the frame ID frameID+"-notices": the writer only sends the metadata of the first frame
+// with a frame ID, so the notice can not be attached to the last frame.
+// Dynamic converters are not supported, as the field types must be known before the first batch.
+//
+// It returns the number of rows written. It does not close rows.
+func StreamFrameFromRows(ctx context.Context, w backend.ChunkedDataWriter, refID, frameID string, rows *sql.Rows, opts StreamOptions, converters ...Converter) (int64, error) {
+	if isDynamic, _ := removeDynamicConverter(converters); isDynamic {
+		return 0, errors.New("dynamic converters are not supported when streaming rows")
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultStreamBatchSize
+	}
+
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return 0, err
+	}
+	names, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	scanRow, err := MakeScanRow(types, names, converters...)
+	if err != nil {
+		return 0, err
+	}
+
+	newBatch := func() *data.Frame {
+		frame := NewFrame(names, scanRow.Converters...)
+		frame.SetRowCapacity(batchSize)
+		return frame
+	}
+
+	// the scan and converted-value buffers are reused across rows, as in FrameFromRows
+	scannable := scanRow.NewScannableRow()
+	converted := make([]interface{}, len(scannable))
+
+	var written int64
+	var sent bool
+	frame := newBatch()
+	limited := false
+
+outer:
+	for {
+		// first iterate over rows may be nop if not switched result set to next
+		for rows.Next() {
+			if err := rows.Scan(scannable...); err != nil {
+				return written, err
+			}
+			if err := appendConvertedRow(frame, scannable, converted, scanRow.Converters); err != nil {
+				return written, err
+			}
+			written++
+
+			if opts.RowLimit > 0 && written == opts.RowLimit {
+				limited = true
+				break outer
+			}
+			if frame.Rows() == batchSize {
+				if err := w.WriteFrame(ctx, refID, frameID, frame); err != nil {
+					return written, err
+				}
+				sent = true
+				frame = newBatch()
+				if err := ctx.Err(); err != nil {
+					return written, err
+				}
+			}
+		}
+
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return written, backend.DownstreamError(err)
+	}
+
+	if frame.Rows() > 0 || !sent {
+		if err := w.WriteFrame(ctx, refID, frameID, frame); err != nil {
+			return written, err
+		}
+	}
+	if limited {
+		notices := data.NewFrame("")
+		notices.AppendNotices(data.Notice{
+			Severity: data.NoticeSeverityWarning,
+			Text:     fmt.Sprintf("Results have been limited to %v because the SQL row limit was reached", opts.RowLimit),
+		})
+		if err := w.WriteFrame(ctx, refID, frameID+"-notices", notices); err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
@@ -0,0 +1,103 @@
+package sqlutil_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend"
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/sqlutil"
+	"github.com/grafana/grafana-plugin-sdk-go/genproto/pluginv2"
+)
+
+type frameRecorder struct {
+	frameIDs []string
+	frames   []*data.Frame
+}
+
+func (r *frameRecorder) WriteFrame(_ context.Context, _ string, frameID string, f *data.Frame) error {
+	r.frameIDs = append(r.frameIDs, frameID)
+	r.frames = append(r.frames, f)
+	return nil
+}
+
+func (r *frameRecorder) WriteError(_ context.Context, _ string, _ backend.Status, err error) error {
+	return err
+}
+
+func TestStreamFrameFromRows(t *testing.T) {
+	columns := []string{"a", "b"}
+	values := [][]interface{}{{1, "x"}, {2, "y"}, {3, "z"}, {4, "w"}, {5, "v"}}
+
+	expected, err := sqlutil.FrameFromRows(makeSingleResultSet(columns, values...), -1) //nolint:rowserrcheck
+	require.NoError(t, err)
+
+	t.Run("writes batches that append to the non-streaming frame", func(t *testing.T) {
+		w := &frameRecorder{}
+		n, err := sqlutil.StreamFrameFromRows(context.Background(), w, "A", "rows", makeSingleResultSet(columns, values...), //nolint:rowserrcheck
+			sqlutil.StreamOptions{BatchSize: 2})
+		require.NoError(t, err)
+		require.Equal(t, int64(5), n)
+		require.Equal(t, []string{"rows", "rows", "rows"}, w.frameIDs)
+		require.Equal(t, []int{2, 2, 1}, []int{w.frames[0].Rows(), w.frames[1].Rows(), w.frames[2].Rows()})
+
+		got, err := data.AppendFrames(w.frames...)
+		require.NoError(t, err)
+		if diff := cmp.Diff(expected, got, data.FrameTestCompareOptions()...); diff != "" {
+			t.Errorf("Result mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("row limit", func(t *testing.T) {
+		var chunks []*pluginv2.QueryChunkedDataResponse
+		w := backend.NewChunkedDataWriter(backend.DataFrameFormat_JSON, func(chunk *pluginv2.QueryChunkedDataResponse) error {
+			chunks = append(chunks, chunk)
+			return nil
+		})
+		n, err := sqlutil.StreamFrameFromRows(context.Background(), w, "A", "rows", makeSingleResultSet(columns, values...), //nolint:rowserrcheck
+			sqlutil.StreamOptions{BatchSize: 2, RowLimit: 4})
+		require.NoError(t, err)
+		require.Equal(t, int64(4), n)
+		require.Len(t, chunks, 3)
+		require.Equal(t, []string{"rows", "rows", "rows-notices"}, []string{chunks[0].FrameId, chunks[1].FrameId, chunks[2].FrameId})
+
+		notices := &data.Frame{}
+		require.NoError(t, json.Unmarshal(chunks[2].Frame, notices))
+		require.Len(t, notices.Meta.Notices, 1)
+		require.Equal(t, data.NoticeSeverityWarning, notices.Meta.Notices[0].Severity)
+	})
+
+	t.Run("multiple result sets", func(t *testing.T) {
+		w := &frameRecorder{}
+		n, err := sqlutil.StreamFrameFromRows(context.Background(), w, "A", "rows", //nolint:rowserrcheck
+			makeMultipleResultSets(columns, values[:2], values[2:]), sqlutil.StreamOptions{BatchSize: 3})
+		require.NoError(t, err)
+		require.Equal(t, int64(5), n)
+		require.Len(t, w.frames, 2)
+
+		got, err := data.AppendFrames(w.frames...)
+		require.NoError(t, err)
+		if diff := cmp.Diff(expected, got, data.FrameTestCompareOptions()...); diff != "" {
+			t.Errorf("Result mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("empty rows write the schema", func(t *testing.T) {
+		w := &frameRecorder{}
+		n, err := sqlutil.StreamFrameFromRows(context.Background(), w, "A", "rows", makeSingleResultSet(columns), sqlutil.StreamOptions{}) //nolint:rowserrcheck
+		require.NoError(t, err)
+		require.Equal(t, int64(0), n)
+		require.Len(t, w.frames, 1)
+		require.Len(t, w.frames[0].Fields, 2)
+	})
+
+	t.Run("dynamic converters are not supported", func(t *testing.T) {
+		_, err := sqlutil.StreamFrameFromRows(context.Background(), &frameRecorder{}, "A", "rows", makeSingleResultSet(columns, values...), //nolint:rowserrcheck
+			sqlutil.StreamOptions{}, sqlutil.Converter{Dynamic: true})
+		require.Error(t, err)
+	})
+}
@@ -110,6 +110,63 @@ func (f *Frame) TimeSeriesSchema() TimeSeriesSchema {
 	return tsSchema
 }
 
+// SeriesRows returns the row indices of each series of frame, which has the TimeSeriesSchema s.
+// A wide frame has a single series with all its rows. The series of a long frame are the rows with
+// the same values of the factor Fields, in the order of their first row.
+func (s TimeSeriesSchema) SeriesRows(frame *Frame) ([][]int, error) {
+	rowLen, err := frame.RowLen()
+	if err != nil {
+		return nil, err
+	}
+	if s.Type == TimeSeriesTypeWide {
+		rows := make([]int, rowLen)
+		for i := range rows {
+			rows[i] = i
+		}
+		return [][]int{rows}, nil
+	}
+
+	var series [][]int
+	seriesIdx := make(map[string]int)
+	factors := make([]interface{}, len(s.FactorIndices))
+	for rowIdx := 0; rowIdx < rowLen; rowIdx++ {
+		for i, fieldIdx := range s.FactorIndices {
+			factors[i], _ = frame.ConcreteAt(fieldIdx, rowIdx)
+		}
+		key, err := json.Marshal(factors)
+		if err != nil {
+			return nil, err
+		}
+		idx, ok := seriesIdx[string(key)]
+		if !ok {
+			idx = len(series)
+			seriesIdx[string(key)] = idx
+			series = append(series, nil)
+		}
+		series[idx] = append(series[idx], rowIdx)
+	}
+	return series, nil
+}
+
+// UnixNanoTimes returns the times of the time Field of frame, which has the TimeSeriesSchema s,
+// as Unix nanoseconds. An error is returned if a time is null or the times are not sorted in
+// ascending order.
+func (s TimeSeriesSchema) UnixNanoTimes(frame *Frame) ([]int64, error) {
+	field := frame.Fields[s.TimeIndex]
+	times := make([]int64, field.Len())
+	for i := range times {
+		v, ok := field.ConcreteAt(i)
+		if !ok {
+			return nil, ErrorNullTimeValues
+		}
+		times[i] = v.(time.Time).UnixNano()
+		if i > 0 && times[i] < times[i-1] {
+			return nil, fmt.Errorf("time field %q is not sorted in ascending order", field.Name)
+		}
+	}
+	return times, nil
+}
+
 // float64ToType converts a float64 value to the specified field type.
 // This is useful if fill missing is enabled and fill missing mode is FillMissingValue,
 // for converting the fill missing value (float64) to the field type.
@@ -164,6 +221,14 @@ func float64ToType(val float64, ftype FieldType) (interface{}, error) {
 		return val, nil
 	case FieldTypeNullableFloat64:
 		return &val, nil
+	case FieldTypeDecimal:
+		return NewDecimalFromFloat64(val)
+	case FieldTypeNullableDecimal:
+		c, err := NewDecimalFromFloat64(val)
+		if err != nil {
+			return nil, err
+		}
+		return &c, nil
 	}
 	// if field type is FieldTypeString, FieldTypeNullableString, FieldTypeBool, FieldTypeNullableBool, FieldTypeTime, FieldTypeNullableTime
 	return val, fmt.Errorf("no numeric value")
@@ -80,6 +80,27 @@ func TestTimeSeriesSchema(t *testing.T) {
 
 var FTV01 = data.FrameTypeVersion{0, 1}
 
+func TestTimeSeriesSchemaSeriesRows(t *testing.T) {
+	long := data.NewFrame("",
+		data.NewField("time", nil, []time.Time{time.Unix(0, 0), time.Unix(0, 0), time.Unix(10, 0)}),
+		data.NewField("host", nil, []string{"b", "a", "b"}),
+		data.NewField("value", nil, []float64{1, 2, 3}),
+	)
+	schema := long.TimeSeriesSchema()
+
+	series, err := schema.SeriesRows(long)
+	require.NoError(t, err)
+	require.Equal(t, [][]int{{0, 2}, {1}}, series)
+
+	times, err := schema.UnixNanoTimes(long)
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 0, 10 * int64(time.Second)}, times)
+
+	long.Fields[0].Set(2, time.Unix(-10, 0))
+	_, err = schema.UnixNanoTimes(long)
+	require.Error(t, err)
+}
+
 func TestLongToWide(t *testing.T) {
 	tests := []struct {
 		name          string
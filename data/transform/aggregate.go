@@ -0,0 +1,228 @@
+package transform
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// AggregationType is the name of an aggregation function.
+type AggregationType string
+
+const (
+	// AggregationSum is the sum of the non-null values.
+	AggregationSum AggregationType = "sum"
+
+	// AggregationCount is the number of non-null values.
+	AggregationCount AggregationType = "count"
+
+	// AggregationMean is the arithmetic mean of the non-null values.
+	AggregationMean AggregationType = "mean"
+
+	// AggregationMin is the smallest non-null value.
+	AggregationMin AggregationType = "min"
+
+	// AggregationMax is the largest non-null value.
+	AggregationMax AggregationType = "max"
+
+	// AggregationLast is the last non-null value.
+	AggregationLast AggregationType = "last"
+
+	// AggregationPercentile is the linearly interpolated percentile of the non-null values.
+	AggregationPercentile AggregationType = "percentile"
+)
+
+// Aggregation describes a reduction of the values of one Field of a group of rows to a single value.
+//
+// Sum, Mean, Min, Max and Percentile require a numeric Field and produce a *float64 Field.
+// Count works on any Field and produces an int64 Field.
+// Last works on any Field and produces a Field of the nullable version of the source Field's type.
+// Null values, and NaN values for numeric aggregations, are ignored. When a group has no values
+// to aggregate the result is null (or 0 for Count).
+type Aggregation struct {
+	// Type is the aggregation function.
+	Type AggregationType
+
+	// Field is the name of the Field to aggregate.
+	Field string
+
+	// Percentile is the percentile, between 0 and 100, used by AggregationPercentile.
+	Percentile float64
+
+	// As is the name of the output Field. When empty, the name is "<Field> (<Type>)", for example "value (sum)".
+	As string
+}
+
+// Sum returns an Aggregation that sums the named Field.
+func Sum(field string) Aggregation {
+	return Aggregation{Type: AggregationSum, Field: field}
+}
+
+// Count returns an Aggregation that counts the non-null values of the named Field.
+func Count(field string) Aggregation {
+	return Aggregation{Type: AggregationCount, Field: field}
+}
+
+// Mean returns an Aggregation that averages the named Field.
+func Mean(field string) Aggregation {
+	return Aggregation{Type: AggregationMean, Field: field}
+}
+
+// Min returns an Aggregation of the minimum of the named Field.
+func Min(field string) Aggregation {
+	return Aggregation{Type: AggregationMin, Field: field}
+}
+
+// Max returns an Aggregation of the maximum of the named Field.
+func Max(field string) Aggregation {
+	return Aggregation{Type: AggregationMax, Field: field}
+}
+
+// Last returns an Aggregation of the last non-null value of the named Field.
+func Last(field string) Aggregation {
+	return Aggregation{Type: AggregationLast, Field: field}
+}
+
+// Percentile returns an Aggregation of the p-th percentile (0-100) of the named Field.
+func Percentile(field string, p float64) Aggregation {
+	return Aggregation{Type: AggregationPercentile, Field: field, Percentile: p}
+}
+
+// Named returns a copy of the Aggregation with the output Field name set to name.
+func (a Aggregation) Named(name string) Aggregation {
+	a.As = name
+	return a
+}
+
+// OutputName returns the name of the Field produced by the Aggregation.
+func (a Aggregation) OutputName() string {
+	if a.As != "" {
+		return a.As
+	}
+	if a.Type == AggregationPercentile {
+		return fmt.Sprintf("%s (p%v)", a.Field, a.Percentile)
+	}
+	return fmt.Sprintf("%s (%s)", a.Field, a.Type)
+}
+
+func (a Aggregation) validate(field *data.Field) error {
+	switch a.Type {
+	case AggregationCount, AggregationLast:
+		return nil
+	case AggregationSum, AggregationMean, AggregationMin, AggregationMax:
+	case AggregationPercentile:
+		if a.Percentile < 0 || a.Percentile > 100 || math.IsNaN(a.Percentile) {
+			return fmt.Errorf("percentile must be between 0 and 100, got %v", a.Percentile)
+		}
+	default:
+		return fmt.Errorf("unknown aggregation type %q", a.Type)
+	}
+	if !field.Type().Numeric() {
+		return fmt.Errorf("aggregation %q requires a numeric field, field %q is of type %s", a.Type, a.Field, field.Type())
+	}
+	return nil
+}
+
+// outputType returns the FieldType of the Field the Aggregation produces from a source Field of type ft.
+func (a Aggregation) outputType(ft data.FieldType) data.FieldType {
+	switch a.Type {
+	case AggregationCount:
+		return data.FieldTypeInt64
+	case AggregationLast:
+		return ft.NullableType()
+	default:
+		return data.FieldTypeNullableFloat64
+	}
+}
+
+// apply reduces the rows of field at the given indices and appends the result to out.
+func (a Aggregation) apply(field *data.Field, rows []int, out *data.Field) error {
+	switch a.Type {
+	case AggregationCount:
+		var n int64
+		for _, rowIdx := range rows {
+			if !field.NilAt(rowIdx) {
+				n++
+			}
+		}
+		out.Append(n)
+		return nil
+	case AggregationLast:
+		for i := len(rows) - 1; i >= 0; i-- {
+			if val, ok := field.ConcreteAt(rows[i]); ok {
+				out.Append(nil)
+				out.SetConcrete(out.Len()-1, val)
+				return nil
+			}
+		}
+		out.Append(nil)
+		return nil
+	}
+
+	values := make([]float64, 0, len(rows))
+	for _, rowIdx := range rows {
+		v, err := field.NullableFloatAt(rowIdx)
+		if err != nil {
+			return err
+		}
+		if v == nil || math.IsNaN(*v) {
+			continue
+		}
+		values = append(values, *v)
+	}
+	if len(values) == 0 {
+		out.Append(nil)
+		return nil
+	}
+
+	var result float64
+	switch a.Type {
+	case AggregationSum:
+		result = sum(values)
+	case AggregationMean:
+		result = sum(values) / float64(len(values))
+	case AggregationMin:
+		result = values[0]
+		for _, v := range values[1:] {
+			result = math.Min(result, v)
+		}
+	case AggregationMax:
+		result = values[0]
+		for _, v := range values[1:] {
+			result = math.Max(result, v)
+		}
+	case AggregationPercentile:
+		result = percentile(values, a.Percentile)
+	}
+	out.Append(&result)
+	return nil
+}
+
+func sum(values []float64) float64 {
+	var s float64
+	for _, v := range values {
+		s += v
+	}
+	return s
+}
+
+// percentile returns the p-th percentile of values using linear interpolation between
+// the closest ranks. values is sorted in place and must not be empty.
+func percentile(values []float64, p float64) float64 {
+	sort.Float64s(values)
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return values[lower]
+	}
+	return values[lower] + (rank-float64(lower))*(values[upper]-values[lower])
+}
+
+// Aggregate reduces all rows of frame to a single row, with one Field per Aggregation.
+// It is equivalent to GroupBy with no key fields.
+func Aggregate(frame *data.Frame, aggs ...Aggregation) (*data.Frame, error) {
+	return GroupBy(frame, nil, aggs...)
+}
@@ -0,0 +1,6 @@
+// Package transform provides relational operations over data frames, such as
+// selecting and renaming fields, filtering rows, grouping rows and aggregating
+// the values of each group.
+//
+// Every operation returns a new *data.Frame and leaves its input unmodified.
+package transform
@@ -0,0 +1,152 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// Row gives a Predicate access to the values of a single row of a Frame by Field name.
+type Row struct {
+	frame   *data.Frame
+	indices map[string]int
+	idx     int
+}
+
+// Index returns the index of the row within the Frame being filtered.
+func (r Row) Index() int {
+	return r.idx
+}
+
+// Value returns the concrete value of the named Field in this row.
+// If the value is null, nil is returned.
+// An error is returned if the Frame has no Field with that name.
+func (r Row) Value(name string) (interface{}, error) {
+	fieldIdx, err := r.fieldIndex(name)
+	if err != nil {
+		return nil, err
+	}
+	val, ok := r.frame.ConcreteAt(fieldIdx, r.idx)
+	if !ok {
+		return nil, nil
+	}
+	return val, nil
+}
+
+// Float returns the value of the named Field in this row as per data.Field.FloatAt.
+// Null numeric values are returned as NaN.
+// An error is returned if the Frame has no Field with that name.
+func (r Row) Float(name string) (float64, error) {
+	fieldIdx, err := r.fieldIndex(name)
+	if err != nil {
+		return 0, err
+	}
+	return r.frame.FloatAt(fieldIdx, r.idx)
+}
+
+func (r Row) fieldIndex(name string) (int, error) {
+	fieldIdx, ok := r.indices[name]
+	if !ok {
+		return 0, fmt.Errorf("field %q not found in frame %q", name, r.frame.Name)
+	}
+	return fieldIdx, nil
+}
+
+// Predicate reports whether a row should be kept by Filter.
+type Predicate func(row Row) (bool, error)
+
+// FieldPredicate returns a Predicate that calls match with the concrete value of the named Field,
+// or nil if the value is null.
+func FieldPredicate(name string, match func(val interface{}) (bool, error)) Predicate {
+	return func(row Row) (bool, error) {
+		val, err := row.Value(name)
+		if err != nil {
+			return false, err
+		}
+		return match(val)
+	}
+}
+
+// And returns a Predicate that is true when all of preds are true.
+// Evaluation stops at the first Predicate that is false or returns an error.
+func And(preds ...Predicate) Predicate {
+	return func(row Row) (bool, error) {
+		for _, p := range preds {
+			ok, err := p(row)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+}
+
+// Or returns a Predicate that is true when any of preds is true.
+// Evaluation stops at the first Predicate that is true or returns an error.
+func Or(preds ...Predicate) Predicate {
+	return func(row Row) (bool, error) {
+		for _, p := range preds {
+			ok, err := p(row)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// Not returns a Predicate that negates pred.
+func Not(pred Predicate) Predicate {
+	return func(row Row) (bool, error) {
+		ok, err := pred(row)
+		if err != nil {
+			return false, err
+		}
+		return !ok, nil
+	}
+}
+
+// Filter returns a new Frame with the rows of frame for which pred returns true.
+// Field types, Labels and Config are kept. If pred returns an error, filtering stops and
+// the error is returned.
+func Filter(frame *data.Frame, pred Predicate) (*data.Frame, error) {
+	rowLen, err := frame.RowLen()
+	if err != nil {
+		return nil, err
+	}
+
+	out := frame.CopyWithoutFields()
+	for _, field := range frame.Fields {
+		out.Fields = append(out.Fields, field.EmptyCopyOfType(field.Type()))
+	}
+
+	row := Row{
+		frame:   frame,
+		indices: fieldIndices(frame),
+	}
+	for rowIdx := 0; rowIdx < rowLen; rowIdx++ {
+		row.idx = rowIdx
+		match, err := pred(row)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			out.AppendRow(frame.RowCopy(rowIdx)...)
+		}
+	}
+	return out, nil
+}
+
+// fieldIndices maps Field names to their index in frame. For duplicate names the first Field wins.
+func fieldIndices(frame *data.Frame) map[string]int {
+	indices := make(map[string]int, len(frame.Fields))
+	for i, field := range frame.Fields {
+		if _, ok := indices[field.Name]; !ok {
+			indices[field.Name] = i
+		}
+	}
+	return indices
+}
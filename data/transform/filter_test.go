@@ -0,0 +1,84 @@
+package transform_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/transform"
+)
+
+func cmpFrames(expected, actual *data.Frame) string {
+	return cmp.Diff(expected, actual, data.FrameTestCompareOptions()...)
+}
+
+func TestFilter(t *testing.T) {
+	frame := data.NewFrame("requests",
+		data.NewField("host", data.Labels{"dc": "eu"}, []string{"a", "b", "a", "c"}),
+		data.NewField("latency", nil, []*float64{ptr(1.0), ptr(10.0), nil, ptr(5.0)}),
+	)
+
+	t.Run("filters on multiple fields", func(t *testing.T) {
+		out, err := transform.Filter(frame, transform.Or(
+			transform.FieldPredicate("host", func(v interface{}) (bool, error) {
+				return v == "a", nil
+			}),
+			func(row transform.Row) (bool, error) {
+				v, err := row.Float("latency")
+				return v > 8, err
+			},
+		))
+		require.NoError(t, err)
+
+		expected := data.NewFrame("requests",
+			data.NewField("host", data.Labels{"dc": "eu"}, []string{"a", "b", "a"}),
+			data.NewField("latency", nil, []*float64{ptr(1.0), ptr(10.0), nil}),
+		)
+		require.Empty(t, cmpFrames(expected, out))
+	})
+
+	t.Run("null values are nil", func(t *testing.T) {
+		out, err := transform.Filter(frame, transform.Not(transform.FieldPredicate("latency", func(v interface{}) (bool, error) {
+			return v == nil, nil
+		})))
+		require.NoError(t, err)
+		require.Equal(t, 3, out.Rows())
+	})
+
+	t.Run("unknown field errors", func(t *testing.T) {
+		_, err := transform.Filter(frame, transform.And(func(row transform.Row) (bool, error) {
+			_, err := row.Value("nope")
+			return true, err
+		}))
+		require.Error(t, err)
+	})
+}
+
+func TestSelectAndRename(t *testing.T) {
+	frame := data.NewFrame("f",
+		data.NewField("a", nil, []int64{1, 2}),
+		data.NewField("b", nil, []string{"x", "y"}),
+	)
+
+	out, err := transform.Select(frame, "b", "a")
+	require.NoError(t, err)
+	require.Empty(t, cmpFrames(data.NewFrame("f",
+		data.NewField("b", nil, []string{"x", "y"}),
+		data.NewField("a", nil, []int64{1, 2}),
+	), out))
+
+	// the output does not share values with the input
+	out.Fields[1].Set(0, int64(42))
+	require.Equal(t, int64(1), frame.At(0, 0))
+
+	_, err = transform.Select(frame, "c")
+	require.Error(t, err)
+
+	out, err = transform.Rename(frame, map[string]string{"a": "id"})
+	require.NoError(t, err)
+	require.Equal(t, "id", out.Fields[0].Name)
+	require.Equal(t, "b", out.Fields[1].Name)
+	require.Equal(t, "a", frame.Fields[0].Name)
+}
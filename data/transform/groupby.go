@@ -0,0 +1,119 @@
+package transform
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// GroupBy groups the rows of frame by the distinct values of the Fields named in keys and
+// reduces each group with aggs.
+//
+// The returned Frame has one row per group, ordered by the first appearance of the group in frame.
+// Its Fields are the key Fields, with their original types, followed by one Field per Aggregation
+// (see Aggregation for the resulting types). Null key values form their own group.
+//
+// When keys is empty all rows form a single group, so the result always has exactly one row.
+func GroupBy(frame *data.Frame, keys []string, aggs ...Aggregation) (*data.Frame, error) {
+	rowLen, err := frame.RowLen()
+	if err != nil {
+		return nil, err
+	}
+
+	keyFields := make([]*data.Field, len(keys))
+	for i, name := range keys {
+		field, idx := frame.FieldByName(name)
+		if idx == -1 {
+			return nil, fmt.Errorf("group by field %q not found in frame %q", name, frame.Name)
+		}
+		keyFields[i] = field
+	}
+
+	aggFields := make([]*data.Field, len(aggs))
+	for i, agg := range aggs {
+		field, idx := frame.FieldByName(agg.Field)
+		if idx == -1 {
+			return nil, fmt.Errorf("aggregation field %q not found in frame %q", agg.Field, frame.Name)
+		}
+		if err := agg.validate(field); err != nil {
+			return nil, err
+		}
+		aggFields[i] = field
+	}
+
+	groups, order := groupRows(keyFields, rowLen)
+
+	out := frame.CopyWithoutFields()
+	for _, field := range keyFields {
+		out.Fields = append(out.Fields, field.EmptyCopyOfType(field.Type()))
+	}
+	for i, agg := range aggs {
+		aggField := aggFields[i].EmptyCopyOfType(agg.outputType(aggFields[i].Type()))
+		aggField.Name = agg.OutputName()
+		if agg.Type == AggregationCount {
+			aggField.Config = nil
+		}
+		out.Fields = append(out.Fields, aggField)
+	}
+
+	for _, key := range order {
+		rows := groups[key]
+		for i, field := range keyFields {
+			out.Fields[i].Append(field.CopyAt(rows[0]))
+		}
+		for i, agg := range aggs {
+			if err := agg.apply(aggFields[i], rows, out.Fields[len(keyFields)+i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+// groupRows returns the row indices of each group, keyed by a string representation of the
+// group's key values, and the order in which the groups first appear.
+func groupRows(keyFields []*data.Field, rowLen int) (map[string][]int, []string) {
+	if len(keyFields) == 0 {
+		rows := make([]int, rowLen)
+		for i := range rows {
+			rows[i] = i
+		}
+		return map[string][]int{"": rows}, []string{""}
+	}
+
+	groups := make(map[string][]int)
+	var order []string
+	var sb strings.Builder
+	for rowIdx := 0; rowIdx < rowLen; rowIdx++ {
+		sb.Reset()
+		for _, field := range keyFields {
+			writeKeyValue(&sb, field, rowIdx)
+			sb.WriteByte(',')
+		}
+		key := sb.String()
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], rowIdx)
+	}
+	return groups, order
+}
+
+// writeKeyValue writes an unambiguous representation of the value of field at rowIdx to sb.
+func writeKeyValue(sb *strings.Builder, field *data.Field, rowIdx int) {
+	val, ok := field.ConcreteAt(rowIdx)
+	if !ok {
+		sb.WriteString("null")
+		return
+	}
+	switch v := val.(type) {
+	case time.Time:
+		// avoid the monotonic clock reading and location, which are part of the String() form.
+		sb.WriteString(strconv.FormatInt(v.UnixNano(), 10))
+	default:
+		sb.WriteString(strconv.Quote(fmt.Sprint(v)))
+	}
+}
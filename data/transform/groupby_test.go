@@ -0,0 +1,116 @@
+package transform_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/transform"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestGroupBy(t *testing.T) {
+	frame := data.NewFrame("requests",
+		data.NewField("host", nil, []string{"a", "b", "a", "b", "a"}),
+		data.NewField("status", nil, []*int64{ptr(int64(200)), ptr(int64(500)), nil, ptr(int64(200)), ptr(int64(200))}),
+		data.NewField("latency", nil, []*float64{ptr(1.0), ptr(10.0), ptr(3.0), nil, ptr(2.0)}),
+	)
+
+	t.Run("aggregates each group", func(t *testing.T) {
+		out, err := transform.GroupBy(frame, []string{"host"},
+			transform.Sum("latency"),
+			transform.Count("latency"),
+			transform.Mean("latency"),
+			transform.Min("latency"),
+			transform.Max("latency"),
+			transform.Last("status"),
+			transform.Percentile("latency", 50).Named("median"),
+		)
+		require.NoError(t, err)
+
+		expected := data.NewFrame("requests",
+			data.NewField("host", nil, []string{"a", "b"}),
+			data.NewField("latency (sum)", nil, []*float64{ptr(6.0), ptr(10.0)}),
+			data.NewField("latency (count)", nil, []int64{3, 1}),
+			data.NewField("latency (mean)", nil, []*float64{ptr(2.0), ptr(10.0)}),
+			data.NewField("latency (min)", nil, []*float64{ptr(1.0), ptr(10.0)}),
+			data.NewField("latency (max)", nil, []*float64{ptr(3.0), ptr(10.0)}),
+			data.NewField("status (last)", nil, []*int64{ptr(int64(200)), ptr(int64(200))}),
+			data.NewField("median", nil, []*float64{ptr(2.0), ptr(10.0)}),
+		)
+		require.Empty(t, cmpFrames(expected, out))
+	})
+
+	t.Run("null keys form their own group", func(t *testing.T) {
+		out, err := transform.GroupBy(frame, []string{"status"}, transform.Count("host"))
+		require.NoError(t, err)
+
+		expected := data.NewFrame("requests",
+			data.NewField("status", nil, []*int64{ptr(int64(200)), ptr(int64(500)), nil}),
+			data.NewField("host (count)", nil, []int64{3, 1, 1}),
+		)
+		require.Empty(t, cmpFrames(expected, out))
+	})
+
+	t.Run("groups of only nulls aggregate to null", func(t *testing.T) {
+		f := data.NewFrame("",
+			data.NewField("k", nil, []string{"x", "y"}),
+			data.NewField("v", nil, []*float64{ptr(1.0), nil}),
+		)
+		out, err := transform.GroupBy(f, []string{"k"}, transform.Max("v"))
+		require.NoError(t, err)
+		require.True(t, out.Fields[1].NilAt(1))
+	})
+
+	t.Run("groups times by instant", func(t *testing.T) {
+		ts := time.Unix(100, 0)
+		f := data.NewFrame("",
+			data.NewField("time", nil, []time.Time{ts, ts.UTC(), ts.Add(time.Second)}),
+			data.NewField("v", nil, []float64{1, 2, 3}),
+		)
+		out, err := transform.GroupBy(f, []string{"time"}, transform.Sum("v"))
+		require.NoError(t, err)
+		require.Equal(t, 2, out.Rows())
+	})
+
+	t.Run("numeric aggregation of a non numeric field errors", func(t *testing.T) {
+		_, err := transform.GroupBy(frame, []string{"status"}, transform.Sum("host"))
+		require.Error(t, err)
+	})
+
+	t.Run("unknown fields error", func(t *testing.T) {
+		_, err := transform.GroupBy(frame, []string{"nope"}, transform.Sum("latency"))
+		require.Error(t, err)
+		_, err = transform.GroupBy(frame, []string{"host"}, transform.Sum("nope"))
+		require.Error(t, err)
+	})
+}
+
+func TestAggregate(t *testing.T) {
+	frame := data.NewFrame("",
+		data.NewField("v", nil, []int32{4, 1, 3, 2}),
+	)
+
+	out, err := transform.Aggregate(frame, transform.Sum("v"), transform.Percentile("v", 75))
+	require.NoError(t, err)
+
+	expected := data.NewFrame("",
+		data.NewField("v (sum)", nil, []*float64{ptr(10.0)}),
+		data.NewField("v (p75)", nil, []*float64{ptr(3.25)}),
+	)
+	require.Empty(t, cmpFrames(expected, out))
+
+	t.Run("empty frame produces one row", func(t *testing.T) {
+		out, err := transform.Aggregate(data.NewFrame("", data.NewField("v", nil, []float64{})),
+			transform.Count("v"), transform.Sum("v"))
+		require.NoError(t, err)
+		require.Equal(t, 1, out.Rows())
+		require.Equal(t, int64(0), out.At(0, 0))
+		require.True(t, out.NilAt(1, 0))
+	})
+}
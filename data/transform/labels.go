@@ -0,0 +1,66 @@
+package transform
+
+import (
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// SelectSeries returns a new Frame with the series of frame whose labels match all matchers.
+//
+// In a long time series frame (see data.TimeSeriesTypeLong), the labels of a row are the values of
+// its string Fields, keyed by Field name, and the rows that do not match are removed.
+// In any other frame the series are the Fields that are not of a time type, their labels are the
+// Field's Labels plus the Field's name as data.MetricNameLabel unless the Labels already have it,
+// and the Fields that do not match are removed. Time Fields are always kept.
+func SelectSeries(frame *data.Frame, matchers data.LabelMatchers) (*data.Frame, error) {
+	return filterSeries(frame, matchers, true)
+}
+
+// DropSeries is the opposite of SelectSeries: it returns a new Frame without the series of frame
+// whose labels match all matchers.
+func DropSeries(frame *data.Frame, matchers data.LabelMatchers) (*data.Frame, error) {
+	return filterSeries(frame, matchers, false)
+}
+
+func filterSeries(frame *data.Frame, matchers data.LabelMatchers, keepMatching bool) (*data.Frame, error) {
+	schema := frame.TimeSeriesSchema()
+	if schema.Type == data.TimeSeriesTypeLong {
+		return Filter(frame, func(row Row) (bool, error) {
+			labels := longRowLabels(frame, schema.FactorIndices, row.Index())
+			return matchers.Matches(labels) == keepMatching, nil
+		})
+	}
+
+	out := frame.CopyWithoutFields()
+	for _, field := range frame.Fields {
+		if field.Type().Time() || matchers.Matches(fieldLabels(field)) == keepMatching {
+			out.Fields = append(out.Fields, field.Copy())
+		}
+	}
+	return out, nil
+}
+
+// fieldLabels returns the Labels of field with its name as the metric name.
+func fieldLabels(field *data.Field) data.Labels {
+	labels := make(data.Labels, len(field.Labels)+1)
+	labels[data.MetricNameLabel] = field.Name
+	for k, v := range field.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// longRowLabels returns the labels of a row of a long frame from the string Fields in factorIndices.
+// Null values are missing labels.
+func longRowLabels(frame *data.Frame, factorIndices []int, rowIdx int) data.Labels {
+	labels := make(data.Labels, len(factorIndices))
+	for _, fieldIdx := range factorIndices {
+		field := frame.Fields[fieldIdx]
+		if field.Type().NonNullableType() != data.FieldTypeString {
+			continue
+		}
+		if v, ok := field.ConcreteAt(rowIdx); ok {
+			labels[field.Name] = v.(string)
+		}
+	}
+	return labels
+}
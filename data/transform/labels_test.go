@@ -0,0 +1,73 @@
+package transform_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+	"github.com/grafana/grafana-plugin-sdk-go/data/transform"
+)
+
+func TestSelectSeriesWide(t *testing.T) {
+	times := []time.Time{time.Unix(0, 0), time.Unix(10, 0)}
+	frame := data.NewFrame("wide",
+		data.NewField("time", nil, times),
+		data.NewField("cpu", data.Labels{"host": "a", "env": "prod"}, []float64{1, 2}),
+		data.NewField("cpu", data.Labels{"host": "b", "env": "dev"}, []float64{3, 4}),
+		data.NewField("mem", data.Labels{"host": "a", "env": "prod"}, []float64{5, 6}),
+	)
+
+	matchers, err := data.ParseLabelMatchers(`cpu{env=~"prod|staging"}`)
+	require.NoError(t, err)
+
+	out, err := transform.SelectSeries(frame, matchers)
+	require.NoError(t, err)
+	expected := data.NewFrame("wide",
+		data.NewField("time", nil, times),
+		data.NewField("cpu", data.Labels{"host": "a", "env": "prod"}, []float64{1, 2}),
+	)
+	require.Empty(t, cmpFrames(expected, out))
+
+	out, err = transform.DropSeries(frame, matchers)
+	require.NoError(t, err)
+	expected = data.NewFrame("wide",
+		data.NewField("time", nil, times),
+		data.NewField("cpu", data.Labels{"host": "b", "env": "dev"}, []float64{3, 4}),
+		data.NewField("mem", data.Labels{"host": "a", "env": "prod"}, []float64{5, 6}),
+	)
+	require.Empty(t, cmpFrames(expected, out))
+
+	// the input frame is not modified
+	require.Len(t, frame.Fields, 4)
+}
+
+func TestSelectSeriesLong(t *testing.T) {
+	times := []time.Time{time.Unix(0, 0), time.Unix(0, 0), time.Unix(10, 0), time.Unix(10, 0)}
+	frame := data.NewFrame("long",
+		data.NewField("time", nil, times),
+		data.NewField("value", nil, []float64{1, 2, 3, 4}),
+		data.NewField("host", nil, []*string{ptr("a"), nil, ptr("a"), nil}),
+		data.NewField("env", nil, []string{"prod", "dev", "prod", "dev"}),
+	).SetMeta(&data.FrameMeta{Type: data.FrameTypeTimeSeriesLong})
+
+	matchers, err := data.ParseLabelMatchers(`{host=""}`)
+	require.NoError(t, err)
+
+	out, err := transform.SelectSeries(frame, matchers)
+	require.NoError(t, err)
+	expected := data.NewFrame("long",
+		data.NewField("time", nil, []time.Time{times[1], times[3]}),
+		data.NewField("value", nil, []float64{2, 4}),
+		data.NewField("host", nil, []*string{nil, nil}),
+		data.NewField("env", nil, []string{"dev", "dev"}),
+	).SetMeta(frame.Meta)
+	require.Empty(t, cmpFrames(expected, out))
+
+	matchers, err = data.ParseLabelMatchers(`env!="dev"`)
+	require.NoError(t, err)
+	out, err = transform.DropSeries(frame, matchers)
+	require.NoError(t, err)
+	require.Empty(t, cmpFrames(expected, out))
+}
@@ -0,0 +1,42 @@
+package transform
+
+import (
+	"fmt"
+
+	"github.com/grafana/grafana-plugin-sdk-go/data"
+)
+
+// Select returns a new Frame that contains a copy of the Fields of frame with the given names,
+// in the order the names are given. An error is returned if a name does not match any Field.
+// If a name matches more than one Field, the first match is used.
+func Select(frame *data.Frame, names ...string) (*data.Frame, error) {
+	out := frame.CopyWithoutFields()
+	for _, name := range names {
+		field, idx := frame.FieldByName(name)
+		if idx == -1 {
+			return nil, fmt.Errorf("field %q not found in frame %q", name, frame.Name)
+		}
+		out.Fields = append(out.Fields, field.Copy())
+	}
+	return out, nil
+}
+
+// Rename returns a copy of frame where each Field whose name is a key of renames
+// is renamed to the corresponding value. An error is returned if a key does not match any Field.
+func Rename(frame *data.Frame, renames map[string]string) (*data.Frame, error) {
+	for from := range renames {
+		if _, idx := frame.FieldByName(from); idx == -1 {
+			return nil, fmt.Errorf("field %q not found in frame %q", from, frame.Name)
+		}
+	}
+
+	out := frame.CopyWithoutFields()
+	for _, field := range frame.Fields {
+		fieldCopy := field.Copy()
+		if to, ok := renames[field.Name]; ok {
+			fieldCopy.Name = to
+		}
+		out.Fields = append(out.Fields, fieldCopy)
+	}
+	return out, nil
+}
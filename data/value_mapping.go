@@ -3,7 +3,11 @@ package data
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -14,6 +18,7 @@ type mappingType string
 const (
 	valueToText  mappingType = "value"
 	rangeToText  mappingType = "range"
+	regexToText  mappingType = "regex"
 	specialValue mappingType = "special"
 )
 
@@ -93,6 +98,13 @@ func (m *ValueMappings) UnmarshalJSON(b []byte) error {
 				return err
 			}
 			mappings = append(mappings, mapper)
+		case regexToText:
+			var mapper RegexValueMapper
+			err := json.Unmarshal(objMap["options"], &mapper)
+			if err != nil {
+				return err
+			}
+			mappings = append(mappings, mapper)
 		case specialValue:
 			var mapper SpecialValueMapper
 			err := json.Unmarshal(objMap["options"], &mapper)
@@ -135,9 +147,174 @@ func (m RangeValueMapper) getType() mappingType {
 	return rangeToText
 }
 
+// RegexValueMapper maps the values that match a regular expression. The Text of the Result is
+// the replacement of the matches in the value, so it can refer to capture groups with $1 or ${name}.
+//
+// The replacement is done with regexp.Regexp.ReplaceAllString, which differs from the JavaScript
+// replace of the frontend: every match is replaced, even without the g flag, and $1abc refers to
+// the capture group named 1abc rather than to $1 followed by abc, so it must be written ${1}abc.
+type RegexValueMapper struct {
+	// Pattern is the regular expression. Like in the frontend, a pattern such as "/error.*/i" is
+	// a regular expression with flags, and any other pattern must match the whole value.
+	Pattern string             `json:"pattern"`
+	Result  ValueMappingResult `json:"result"`
+}
+
+func (m RegexValueMapper) getType() mappingType {
+	return regexToText
+}
+
 // Make sure each type implements all required interfaces
 var (
 	_ ValueMapping = (*ValueMapper)(nil)
 	_ ValueMapping = (*RangeValueMapper)(nil)
+	_ ValueMapping = (*RegexValueMapper)(nil)
 	_ ValueMapping = (*SpecialValueMapper)(nil)
 )
+
+// Map returns the result of the first mapping that matches value, with the semantics of the
+// frontend's value mappings. value is a concrete value as returned by Field.ConcreteAt,
+// or nil for null. If no mapping matches, ok is false.
+//
+// The patterns of the RegexValueMappers are compiled at each call. DisplayProcessor compiles
+// them once to map many values.
+func (m ValueMappings) Map(value interface{}) (result ValueMappingResult, ok bool) {
+	return m.mapValue(value, nil)
+}
+
+// regexps returns the compiled pattern of each RegexValueMapper of m at its index, and nil for
+// the other mappings and the invalid patterns.
+func (m ValueMappings) regexps() []*regexp.Regexp {
+	regexps := make([]*regexp.Regexp, len(m))
+	for i, mapping := range m {
+		if mapper, ok := mapping.(RegexValueMapper); ok {
+			regexps[i], _ = mappingRegexp(mapper.Pattern)
+		}
+	}
+	return regexps
+}
+
+// mapValue is Map with the regexps of m, which are compiled as needed if regexps is nil.
+func (m ValueMappings) mapValue(value interface{}, regexps []*regexp.Regexp) (result ValueMappingResult, ok bool) {
+	for i, mapping := range m {
+		switch mapper := mapping.(type) {
+		case ValueMapper:
+			if value == nil {
+				continue
+			}
+			if result, ok := mapper[mappingValueString(value)]; ok {
+				return result, true
+			}
+		case RangeValueMapper:
+			if f, ok := concreteFloat(value); ok && mapper.matches(f) {
+				return mapper.Result, true
+			}
+		case RegexValueMapper:
+			s, isString := value.(string)
+			if !isString {
+				continue // like the frontend, only strings are matched
+			}
+			var re *regexp.Regexp
+			if regexps != nil {
+				re = regexps[i]
+			} else {
+				re, _ = mappingRegexp(mapper.Pattern)
+			}
+			if re == nil {
+				continue
+			}
+			if re.MatchString(s) {
+				result := mapper.Result
+				if result.Text != "" {
+					result.Text = re.ReplaceAllString(s, result.Text)
+				}
+				return result, true
+			}
+		case SpecialValueMapper:
+			if mapper.matches(value) {
+				return mapper.Result, true
+			}
+		}
+	}
+	return ValueMappingResult{}, false
+}
+
+func (m RangeValueMapper) matches(v float64) bool {
+	if math.IsNaN(v) {
+		return false
+	}
+	hasFrom := m.From != nil && !math.IsNaN(float64(*m.From))
+	hasTo := m.To != nil && !math.IsNaN(float64(*m.To))
+	switch {
+	case hasFrom && hasTo:
+		return v >= float64(*m.From) && v <= float64(*m.To)
+	case hasFrom:
+		return v >= float64(*m.From)
+	case hasTo:
+		return v <= float64(*m.To)
+	default:
+		return false
+	}
+}
+
+func (m SpecialValueMapper) matches(value interface{}) bool {
+	f, isNumber := concreteFloat(value)
+	isNaN := isNumber && math.IsNaN(f)
+	switch m.Match {
+	case SpecialValueNull:
+		return value == nil
+	case SpecialValueNaN:
+		return isNaN
+	case SpecialValueNullAndNaN:
+		return value == nil || isNaN
+	case SpecialValueTrue:
+		return value == true || value == "true"
+	case SpecialValueFalse:
+		return value == false || value == "false"
+	case SpecialValueEmpty:
+		return value == ""
+	default:
+		return false
+	}
+}
+
+// mappingValueString returns the string of a concrete value that is matched by value mappings,
+// like the String() of the value in the frontend.
+func mappingValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case time.Time:
+		return strconv.FormatInt(v.UnixMilli(), 10)
+	case json.RawMessage:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// mappingRegexp compiles the pattern of a RegexValueMapper like stringToJsRegex in the frontend.
+func mappingRegexp(pattern string) (*regexp.Regexp, error) {
+	expr := "^(?:" + pattern + ")$"
+	if end := strings.LastIndex(pattern, "/"); strings.HasPrefix(pattern, "/") && end > 0 {
+		expr = pattern[1:end]
+		flags := ""
+		for _, flag := range pattern[end+1:] {
+			switch flag {
+			case 'i', 'm', 's':
+				flags += string(flag)
+			case 'g', 'u', 'y':
+			default:
+				return nil, fmt.Errorf("invalid regular expression flag %q", flag)
+			}
+		}
+		if flags != "" {
+			expr = "(?" + flags + ")" + expr
+		}
+	}
+	return regexp.Compile(expr)
+}
@@ -101,6 +101,14 @@ func vectorFieldType(v vector) FieldType {
 		return FieldTypeEnum
 	case *nullableEnumVector:
 		return FieldTypeNullableEnum
+	case *decimalVector:
+		return FieldTypeDecimal
+	case *nullableDecimalVector:
+		return FieldTypeNullableDecimal
+	case *durationVector:
+		return FieldTypeDuration
+	case *nullableDurationVector:
+		return FieldTypeNullableDuration
 	}
 
 	return FieldTypeUnknown
@@ -196,6 +204,16 @@ func NewFieldFromFieldType(p FieldType, n int) *Field {
 		f.vector = newEnumVector(n)
 	case FieldTypeNullableEnum:
 		f.vector = newNullableEnumVector(n)
+
+	case FieldTypeDecimal:
+		f.vector = newDecimalVector(n)
+	case FieldTypeNullableDecimal:
+		f.vector = newNullableDecimalVector(n)
+
+	case FieldTypeDuration:
+		f.vector = newDurationVector(n)
+	case FieldTypeNullableDuration:
+		f.vector = newNullableDurationVector(n)
 	default:
 		panic("unsupported FieldType")
 	}
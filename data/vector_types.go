@@ -0,0 +1,318 @@
+package data
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// The vectors of each FieldType. They are instantiations of genericVector and nullableGenericVector,
+// named so they can be used in type switches.
+
+type (
+	uint8Vector                  = genericVector[uint8]
+	nullableUint8Vector          = nullableGenericVector[uint8]
+	uint16Vector                 = genericVector[uint16]
+	nullableUint16Vector         = nullableGenericVector[uint16]
+	uint32Vector                 = genericVector[uint32]
+	nullableUint32Vector         = nullableGenericVector[uint32]
+	uint64Vector                 = genericVector[uint64]
+	nullableUint64Vector         = nullableGenericVector[uint64]
+	int8Vector                   = genericVector[int8]
+	nullableInt8Vector           = nullableGenericVector[int8]
+	int16Vector                  = genericVector[int16]
+	nullableInt16Vector          = nullableGenericVector[int16]
+	int32Vector                  = genericVector[int32]
+	nullableInt32Vector          = nullableGenericVector[int32]
+	int64Vector                  = genericVector[int64]
+	nullableInt64Vector          = nullableGenericVector[int64]
+	float32Vector                = genericVector[float32]
+	nullableFloat32Vector        = nullableGenericVector[float32]
+	float64Vector                = genericVector[float64]
+	nullableFloat64Vector        = nullableGenericVector[float64]
+	stringVector                 = genericVector[string]
+	nullableStringVector         = nullableGenericVector[string]
+	boolVector                   = genericVector[bool]
+	nullableBoolVector           = nullableGenericVector[bool]
+	timeTimeVector               = genericVector[time.Time]
+	nullableTimeTimeVector       = nullableGenericVector[time.Time]
+	jsonRawMessageVector         = genericVector[json.RawMessage]
+	nullableJsonRawMessageVector = nullableGenericVector[json.RawMessage]
+	enumVector                   = genericVector[EnumItemIndex]
+	nullableEnumVector           = nullableGenericVector[EnumItemIndex]
+	decimalVector                = genericVector[Decimal]
+	nullableDecimalVector        = nullableGenericVector[Decimal]
+	durationVector               = genericVector[time.Duration]
+	nullableDurationVector       = nullableGenericVector[time.Duration]
+)
+
+func newUint8Vector(n int) *uint8Vector {
+	return newGenericVector[uint8](n)
+}
+
+func newUint8VectorWithValues(s []uint8) *uint8Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableUint8Vector(n int) *nullableUint8Vector {
+	return newNullableGenericVector[uint8](n)
+}
+
+func newNullableUint8VectorWithValues(s []*uint8) *nullableUint8Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newUint16Vector(n int) *uint16Vector {
+	return newGenericVector[uint16](n)
+}
+
+func newUint16VectorWithValues(s []uint16) *uint16Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableUint16Vector(n int) *nullableUint16Vector {
+	return newNullableGenericVector[uint16](n)
+}
+
+func newNullableUint16VectorWithValues(s []*uint16) *nullableUint16Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newUint32Vector(n int) *uint32Vector {
+	return newGenericVector[uint32](n)
+}
+
+func newUint32VectorWithValues(s []uint32) *uint32Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableUint32Vector(n int) *nullableUint32Vector {
+	return newNullableGenericVector[uint32](n)
+}
+
+func newNullableUint32VectorWithValues(s []*uint32) *nullableUint32Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newUint64Vector(n int) *uint64Vector {
+	return newGenericVector[uint64](n)
+}
+
+func newUint64VectorWithValues(s []uint64) *uint64Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableUint64Vector(n int) *nullableUint64Vector {
+	return newNullableGenericVector[uint64](n)
+}
+
+func newNullableUint64VectorWithValues(s []*uint64) *nullableUint64Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newInt8Vector(n int) *int8Vector {
+	return newGenericVector[int8](n)
+}
+
+func newInt8VectorWithValues(s []int8) *int8Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableInt8Vector(n int) *nullableInt8Vector {
+	return newNullableGenericVector[int8](n)
+}
+
+func newNullableInt8VectorWithValues(s []*int8) *nullableInt8Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newInt16Vector(n int) *int16Vector {
+	return newGenericVector[int16](n)
+}
+
+func newInt16VectorWithValues(s []int16) *int16Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableInt16Vector(n int) *nullableInt16Vector {
+	return newNullableGenericVector[int16](n)
+}
+
+func newNullableInt16VectorWithValues(s []*int16) *nullableInt16Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newInt32Vector(n int) *int32Vector {
+	return newGenericVector[int32](n)
+}
+
+func newInt32VectorWithValues(s []int32) *int32Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableInt32Vector(n int) *nullableInt32Vector {
+	return newNullableGenericVector[int32](n)
+}
+
+func newNullableInt32VectorWithValues(s []*int32) *nullableInt32Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newInt64Vector(n int) *int64Vector {
+	return newGenericVector[int64](n)
+}
+
+func newInt64VectorWithValues(s []int64) *int64Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableInt64Vector(n int) *nullableInt64Vector {
+	return newNullableGenericVector[int64](n)
+}
+
+func newNullableInt64VectorWithValues(s []*int64) *nullableInt64Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newFloat32Vector(n int) *float32Vector {
+	return newGenericVector[float32](n)
+}
+
+func newFloat32VectorWithValues(s []float32) *float32Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableFloat32Vector(n int) *nullableFloat32Vector {
+	return newNullableGenericVector[float32](n)
+}
+
+func newNullableFloat32VectorWithValues(s []*float32) *nullableFloat32Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newFloat64Vector(n int) *float64Vector {
+	return newGenericVector[float64](n)
+}
+
+func newFloat64VectorWithValues(s []float64) *float64Vector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableFloat64Vector(n int) *nullableFloat64Vector {
+	return newNullableGenericVector[float64](n)
+}
+
+func newNullableFloat64VectorWithValues(s []*float64) *nullableFloat64Vector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newStringVector(n int) *stringVector {
+	return newGenericVector[string](n)
+}
+
+func newStringVectorWithValues(s []string) *stringVector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableStringVector(n int) *nullableStringVector {
+	return newNullableGenericVector[string](n)
+}
+
+func newNullableStringVectorWithValues(s []*string) *nullableStringVector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newBoolVector(n int) *boolVector {
+	return newGenericVector[bool](n)
+}
+
+func newBoolVectorWithValues(s []bool) *boolVector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableBoolVector(n int) *nullableBoolVector {
+	return newNullableGenericVector[bool](n)
+}
+
+func newNullableBoolVectorWithValues(s []*bool) *nullableBoolVector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newTimeTimeVector(n int) *timeTimeVector {
+	return newGenericVector[time.Time](n)
+}
+
+func newTimeTimeVectorWithValues(s []time.Time) *timeTimeVector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableTimeTimeVector(n int) *nullableTimeTimeVector {
+	return newNullableGenericVector[time.Time](n)
+}
+
+func newNullableTimeTimeVectorWithValues(s []*time.Time) *nullableTimeTimeVector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newJsonRawMessageVector(n int) *jsonRawMessageVector {
+	return newGenericVector[json.RawMessage](n)
+}
+
+func newJsonRawMessageVectorWithValues(s []json.RawMessage) *jsonRawMessageVector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableJsonRawMessageVector(n int) *nullableJsonRawMessageVector {
+	return newNullableGenericVector[json.RawMessage](n)
+}
+
+func newNullableJsonRawMessageVectorWithValues(s []*json.RawMessage) *nullableJsonRawMessageVector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newEnumVector(n int) *enumVector {
+	return newGenericVector[EnumItemIndex](n)
+}
+
+func newEnumVectorWithValues(s []EnumItemIndex) *enumVector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableEnumVector(n int) *nullableEnumVector {
+	return newNullableGenericVector[EnumItemIndex](n)
+}
+
+func newNullableEnumVectorWithValues(s []*EnumItemIndex) *nullableEnumVector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newDecimalVector(n int) *decimalVector {
+	return newGenericVector[Decimal](n)
+}
+
+func newDecimalVectorWithValues(s []Decimal) *decimalVector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableDecimalVector(n int) *nullableDecimalVector {
+	return newNullableGenericVector[Decimal](n)
+}
+
+func newNullableDecimalVectorWithValues(s []*Decimal) *nullableDecimalVector {
+	return newNullableGenericVectorWithValues(s)
+}
+
+func newDurationVector(n int) *durationVector {
+	return newGenericVector[time.Duration](n)
+}
+
+func newDurationVectorWithValues(s []time.Duration) *durationVector {
+	return newGenericVectorWithValues(s)
+}
+
+func newNullableDurationVector(n int) *nullableDurationVector {
+	return newNullableGenericVector[time.Duration](n)
+}
+
+func newNullableDurationVectorWithValues(s []*time.Duration) *nullableDurationVector {
+	return newNullableGenericVectorWithValues(s)
+}
@@ -65,8 +65,8 @@ func CheckGoldenDataResponse(path string, dr *backend.DataResponse, updateFile b
 	// Check each frame
 	for idx, frame := range dr.Frames {
 		expectedFrame := saved.Frames[idx]
-		if diff := cmp.Diff(expectedFrame, frame, data.FrameTestCompareOptions()...); diff != "" {
-			errorString += fmt.Sprintf("frame[%d] mismatch (-want +got):\n%s\n", idx, diff)
+		if diff := data.DiffFrames(expectedFrame, frame, data.FrameDiffOptions{MaxValueDiffs: 10}); !diff.Empty() {
+			errorString += fmt.Sprintf("frame[%d] mismatch (want != got):\n%s\n", idx, diff)
 		}
 	}
 
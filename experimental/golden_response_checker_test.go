@@ -89,6 +89,21 @@ func TestGoldenResponseChecker(t *testing.T) {
 	})
 }
 
+func TestGoldenResponseCheckerMismatch(t *testing.T) {
+	goldenFile := filepath.Join(t.TempDir(), "mismatch.golden.txt")
+	dr := &backend.DataResponse{Frames: data.Frames{
+		data.NewFrame("Frame", data.NewField("value", nil, []float64{1, 2, 3})),
+	}}
+	require.Error(t, CheckGoldenDataResponse(goldenFile, dr, true)) // writes the missing golden file
+	require.NoError(t, CheckGoldenDataResponse(goldenFile, dr, false))
+
+	changed := &backend.DataResponse{Frames: data.Frames{
+		data.NewFrame("Frame", data.NewField("value", nil, []float64{1, 5, 3})),
+	}}
+	err := CheckGoldenDataResponse(goldenFile, changed, false)
+	require.EqualError(t, err, "frame[0] mismatch (want != got):\nfield 0 \"value\":\n  row 1: 2 != 5\n\n")
+}
+
 func TestGoldenJSONFrame(t *testing.T) {
 	f := data.NewFrame("Frame One",
 		data.NewField("Single float64", nil, []float64{
@@ -4,7 +4,6 @@ go 1.26.5
 
 require (
 	github.com/apache/arrow-go/v18 v18.7.0
-	github.com/cheekybits/genny v1.0.0
 	github.com/chromedp/cdproto v0.0.0-20260804232424-e85f50dbfd32
 	github.com/elazarl/goproxy v1.9.0
 	github.com/getkin/kin-openapi v0.144.0
@@ -60,6 +59,8 @@ require github.com/go-openapi/jsonreference v1.0.0
 
 require (
 	github.com/BurntSushi/toml v1.6.0 // indirect
+	github.com/andybalholm/brotli v1.2.2 // indirect
+	github.com/apache/thrift v0.24.0 // indirect
 	github.com/bahlo/generic-list-go v0.2.0 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/buger/jsonparser v1.6.1 // indirect